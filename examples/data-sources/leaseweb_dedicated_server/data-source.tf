@@ -0,0 +1,15 @@
+data "leaseweb_dedicated_server" "db01" {
+  reference = "db01"
+}
+
+output "db01_public_ip" {
+  value = data.leaseweb_dedicated_server.db01.public_ip
+}
+
+output "db01_rack" {
+  value = data.leaseweb_dedicated_server.db01.location["rack"]
+}
+
+output "db01_remote_management_ip" {
+  value = data.leaseweb_dedicated_server.db01.remote_management_ip
+}
@@ -0,0 +1,6 @@
+# Access the remote management credentials of a server, including their passwords
+data "leaseweb_dedicated_server_credentials" "ipmi" {
+  dedicated_server_id = "1234567"
+  type                = "REMOTE_MANAGEMENT"
+  include_passwords   = true
+}
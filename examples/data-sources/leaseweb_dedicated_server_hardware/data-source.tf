@@ -0,0 +1,15 @@
+data "leaseweb_dedicated_server_hardware" "db01" {
+  dedicated_server_id = "1234567"
+}
+
+# Mirror all the disks of the server
+resource "leaseweb_dedicated_server_installation" "db01" {
+  dedicated_server_id = data.leaseweb_dedicated_server_hardware.db01.dedicated_server_id
+  operating_system_id = "UBUNTU_22_04_64BIT"
+
+  raid {
+    type            = "SW"
+    level           = 1
+    number_of_disks = length(data.leaseweb_dedicated_server_hardware.db01.disks)
+  }
+}
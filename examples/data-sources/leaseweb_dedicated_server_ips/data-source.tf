@@ -0,0 +1,6 @@
+# Access all the public IPv4 addresses of a server
+data "leaseweb_dedicated_server_ips" "public_ipv4" {
+  dedicated_server_id = "1234567"
+  network_type        = "PUBLIC"
+  version             = 4
+}
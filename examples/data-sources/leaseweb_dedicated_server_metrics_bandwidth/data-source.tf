@@ -0,0 +1,15 @@
+# 95th percentile of the bandwidth used last month
+data "leaseweb_dedicated_server_metrics_bandwidth" "web01" {
+  dedicated_server_id = "1234567"
+  from                = "2023-01-01T00:00:00Z"
+  to                  = "2023-02-01T00:00:00Z"
+  aggregation         = "95TH"
+}
+
+# Get notified when the bandwidth goes 50% above what was observed
+resource "leaseweb_dedicated_server_notification_setting_bandwidth" "web01" {
+  dedicated_server_id = "1234567"
+  frequency           = "DAILY"
+  threshold           = ceil(max(data.leaseweb_dedicated_server_metrics_bandwidth.web01.up_max, data.leaseweb_dedicated_server_metrics_bandwidth.web01.down_max) * 1.5 / 1000000)
+  unit                = "Mbps"
+}
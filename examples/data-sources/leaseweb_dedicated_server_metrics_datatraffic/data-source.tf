@@ -0,0 +1,11 @@
+# Daily data traffic of last month
+data "leaseweb_dedicated_server_metrics_datatraffic" "web01" {
+  dedicated_server_id = "1234567"
+  from                = "2023-01-01T00:00:00Z"
+  to                  = "2023-02-01T00:00:00Z"
+  granularity         = "DAY"
+}
+
+output "web01_datatraffic_total" {
+  value = "${data.leaseweb_dedicated_server_metrics_datatraffic.web01.up_total + data.leaseweb_dedicated_server_metrics_datatraffic.web01.down_total} ${data.leaseweb_dedicated_server_metrics_datatraffic.web01.unit}"
+}
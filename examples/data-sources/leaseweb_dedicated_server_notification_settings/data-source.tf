@@ -0,0 +1,8 @@
+data "leaseweb_dedicated_server_notification_settings" "web01_bandwidth" {
+  dedicated_server_id = "1234567"
+  type                = "bandwidth"
+}
+
+output "web01_exceeded_bandwidth_notifications" {
+  value = [for setting in data.leaseweb_dedicated_server_notification_settings.web01_bandwidth.notification_settings : setting.id if setting.threshold_exceeded_at != ""]
+}
@@ -0,0 +1,11 @@
+data "leaseweb_dedicated_server_null_route_history" "web01" {
+  dedicated_server_id = "1234567"
+}
+
+# Warn when one of the IPs of the server is currently null routed
+check "web01_null_routes" {
+  assert {
+    condition     = alltrue([for null_route in data.leaseweb_dedicated_server_null_route_history.web01.null_routes : null_route.unnulled_at != ""])
+    error_message = "An IP of web01 is currently null routed."
+  }
+}
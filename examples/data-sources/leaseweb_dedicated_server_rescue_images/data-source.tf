@@ -0,0 +1,2 @@
+data "leaseweb_dedicated_server_rescue_images" "all_rescue_images" {
+}
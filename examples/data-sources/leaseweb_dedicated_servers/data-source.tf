@@ -2,3 +2,13 @@
 data "leaseweb_dedicated_servers" "ams_01_servers" {
   site = "AMS-01"
 }
+
+# Access the web servers in a private rack
+data "leaseweb_dedicated_servers" "web_servers" {
+  private_rack_id = "1234"
+  reference_regex = "^web[0-9]+$"
+}
+
+output "web_servers_public_ips" {
+  value = { for server in data.leaseweb_dedicated_servers.web_servers.servers : server.reference => server.public_ip }
+}
@@ -0,0 +1,3 @@
+data "leaseweb_private_network_dhcp_reservations" "app" {
+  private_network_id = "9876"
+}
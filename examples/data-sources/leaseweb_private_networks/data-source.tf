@@ -0,0 +1,7 @@
+# Access all the private networks
+data "leaseweb_private_networks" "all" {}
+
+# Access the private network named app
+data "leaseweb_private_networks" "app" {
+  name = "app"
+}
@@ -1,8 +1,13 @@
 resource "leaseweb_dedicated_server" "web01" {
-  reference                       = "web01"
-  reverse_lookup                  = "web01.example.com"
-  dhcp_lease                      = "https://boot.netboot.xyz"
-  powered_on                      = true
-  public_network_interface_opened = true
-  public_ip_null_routed           = false
+  reference                          = "web01"
+  reverse_lookup                     = "web01.example.com"
+  dhcp_lease                         = "https://boot.netboot.xyz"
+  powered_on                         = true
+  public_network_interface_opened    = true
+  remote_management_interface_opened = false
+  public_ip_null_routed              = false
+}
+
+output "web01_price" {
+  value = "${leaseweb_dedicated_server.web01.contract[0].price_per_frequency} ${leaseweb_dedicated_server.web01.contract[0].currency}"
 }
@@ -4,3 +4,15 @@ resource "leaseweb_dedicated_server_credential" "control_panel" {
   username            = "AzureDiamond"
   password            = "hunter2"
 }
+
+# Generate the password and rotate it whenever the keepers change
+resource "leaseweb_dedicated_server_credential" "remote_management" {
+  dedicated_server_id = "1234567"
+  type                = "REMOTE_MANAGEMENT"
+  username            = "ADMIN"
+  password_length     = 20
+
+  keepers = {
+    rotation = "2024-Q1"
+  }
+}
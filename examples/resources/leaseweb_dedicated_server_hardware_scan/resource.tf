@@ -0,0 +1,15 @@
+resource "leaseweb_dedicated_server_hardware_scan" "refurbished" {
+  dedicated_server_id = "1234567"
+  power_cycle         = true
+
+  triggers = {
+    ticket = "REFURB-42"
+  }
+}
+
+check "disks_healthy" {
+  assert {
+    condition     = alltrue([for disk in leaseweb_dedicated_server_hardware_scan.refurbished.disks : disk.smart_status == "PASSED"])
+    error_message = "At least one disk failed its SMART check."
+  }
+}
@@ -0,0 +1,2 @@
+# Format of argument is dedicated_server_id:ip
+$ terraform import leaseweb_dedicated_server_ip.mail "1234567:85.17.0.2"
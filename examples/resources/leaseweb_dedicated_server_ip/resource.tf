@@ -0,0 +1,13 @@
+resource "leaseweb_dedicated_server_ip" "mail" {
+  dedicated_server_id = "1234567"
+  ip                  = "85.17.0.2"
+  reverse_lookup      = "mail.example.com"
+  null_routed         = false
+}
+
+# Manage the reverse lookup of an address of the IPv6 subnet of the server
+resource "leaseweb_dedicated_server_ip" "mail_ipv6" {
+  dedicated_server_id = "1234567"
+  ip                  = "2001:db8:85a3::25"
+  reverse_lookup      = "mail.example.com"
+}
@@ -0,0 +1,13 @@
+variable "ipmi_reset_ticket" {
+  type = string
+}
+
+# Reset the IPMI every time the maintenance ticket changes
+resource "leaseweb_dedicated_server_ipmi_reset" "web01" {
+  dedicated_server_id = "1234567"
+  power_cycle         = false
+
+  triggers = {
+    ticket = var.ipmi_reset_ticket
+  }
+}
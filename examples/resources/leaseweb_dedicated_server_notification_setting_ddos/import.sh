@@ -0,0 +1,2 @@
+# Format of argument is dedicated_server_id
+$ terraform import leaseweb_dedicated_server_notification_setting_ddos.web01 "1234567"
@@ -0,0 +1,6 @@
+# Only get notified about null routes, scrubbing is handled automatically
+resource "leaseweb_dedicated_server_notification_setting_ddos" "web01" {
+  dedicated_server_id = "1234567"
+  nulling             = true
+  scrubbing           = false
+}
@@ -0,0 +1,12 @@
+resource "leaseweb_dedicated_server" "web01" {
+  dhcp_lease = "https://boot.netboot.xyz"
+}
+
+# Reboot the server whenever its PXE boot lease changes
+resource "leaseweb_dedicated_server_power_cycle" "web01" {
+  dedicated_server_id = leaseweb_dedicated_server.web01.id
+
+  triggers = {
+    dhcp_lease = leaseweb_dedicated_server.web01.dhcp_lease
+  }
+}
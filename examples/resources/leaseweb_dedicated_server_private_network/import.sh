@@ -0,0 +1,2 @@
+# Format of argument is dedicated_server_id:private_network_id
+$ terraform import leaseweb_dedicated_server_private_network.web01 "1234567:9876"
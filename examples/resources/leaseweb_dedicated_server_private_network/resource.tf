@@ -0,0 +1,13 @@
+data "leaseweb_private_networks" "app" {
+  name = "app"
+}
+
+resource "leaseweb_dedicated_server_private_network" "web01" {
+  dedicated_server_id = "1234567"
+  private_network_id  = data.leaseweb_private_networks.app.ids[0]
+  link_speed          = 1000
+}
+
+output "web01_private_ip" {
+  value = leaseweb_dedicated_server_private_network.web01.private_ip
+}
@@ -0,0 +1,6 @@
+resource "leaseweb_dedicated_server_rescue_mode" "repair" {
+  dedicated_server_id = "1234567"
+  rescue_image_id     = "GRML"
+  ssh_keys            = ["ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIHtbIXqlHmzDmcq5RDqYBpuGXvVnn4SSK0lDdu7hMrfS user@example.com"]
+  callback_url        = "https://example.com/callbacks/rescue"
+}
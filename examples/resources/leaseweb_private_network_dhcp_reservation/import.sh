@@ -0,0 +1,2 @@
+# Format of argument is private_network_id:ip
+$ terraform import leaseweb_private_network_dhcp_reservation.web01 "9876:10.0.0.11"
@@ -0,0 +1,11 @@
+data "leaseweb_dedicated_server" "web01" {
+  reference = "web01"
+}
+
+# Always give the same private IP to web01
+resource "leaseweb_private_network_dhcp_reservation" "web01" {
+  private_network_id = "9876"
+  ip                 = "10.0.0.11"
+  mac_address        = data.leaseweb_dedicated_server.web01.network_interfaces[0].internal[0].mac_address
+  sticky             = true
+}
@@ -22,17 +22,59 @@ var (
 
 // Server -
 type Server struct {
-	ID       string
-	Contract struct {
-		Reference string
+	ID           string
+	AssetID      string
+	SerialNumber string
+	Contract     struct {
+		ID                      string
+		CustomerID              string
+		SalesOrgID              string
+		DeliveryStatus          string
+		Reference               string
+		Status                  string
+		ContractType            string
+		ContractTerm            FlexibleInt
+		BillingCycle            FlexibleInt
+		BillingFrequency        string
+		PricePerFrequency       float64
+		Currency                string
+		SLA                     string
+		StartsAt                string
+		EndsAt                  string
+		PrivateNetworkPortSpeed FlexibleInt
+		NetworkTraffic          struct {
+			Type             string
+			ConnectivityType string
+			TrafficType      string
+			DatatrafficUnit  string
+			DatatrafficLimit FlexibleInt
+		}
 	}
-	NetworkInterfaces struct {
-		Public struct {
-			IP string
+	Specs struct {
+		Chassis             string
+		HardwareRaidCapable bool
+		CPU                 struct {
+			Quantity int
+			Type     string
 		}
-		RemoteManagement struct {
-			IP string
+		RAMSize int
+		RAMUnit string
+		HDD     []struct {
+			ID              string
+			Amount          int
+			Size            float64
+			Type            string
+			Unit            string
+			PerformanceType string
 		}
+		PCICards []struct {
+			Description string
+		}
+	}
+	NetworkInterfaces struct {
+		Public           ServerNetworkInterface
+		Internal         ServerNetworkInterface
+		RemoteManagement ServerNetworkInterface
 	}
 	Location struct {
 		Site  string
@@ -40,13 +82,156 @@ type Server struct {
 		Rack  string
 		Unit  string
 	}
+	Rack struct {
+		Type string
+	}
+	PrivateNetworks []ServerPrivateNetwork
+}
+
+// ServerPrivateNetwork -
+type ServerPrivateNetwork struct {
+	ID        string
+	LinkSpeed int
+	Status    string
+	Subnet    string
+	VLANID    string
+}
+
+// GetPrivateNetwork returns the private network of the server with the given ID, or nil when the server is not part of it.
+func (server *Server) GetPrivateNetwork(privateNetworkID string) *ServerPrivateNetwork {
+	for i := range server.PrivateNetworks {
+		if server.PrivateNetworks[i].ID == privateNetworkID {
+			return &server.PrivateNetworks[i]
+		}
+	}
+	return nil
+}
+
+// ServerNetworkInterface -
+type ServerNetworkInterface struct {
+	MAC          string
+	IP           string
+	PrefixLength int `json:"-"`
+	Gateway      string
+}
+
+// splitIPPrefixes moves the prefix length of the network interface IPs returned by the API to their own field
+// and normalizes the addresses.
+func (server *Server) splitIPPrefixes() {
+	for _, networkInterface := range []*ServerNetworkInterface{
+		&server.NetworkInterfaces.Public,
+		&server.NetworkInterfaces.Internal,
+		&server.NetworkInterfaces.RemoteManagement,
+	} {
+		networkInterface.IP, networkInterface.PrefixLength = splitIPPrefix(networkInterface.IP)
+		networkInterface.Gateway, _ = splitIPPrefix(networkInterface.Gateway)
+	}
+}
+
+// ServerFilters -
+type ServerFilters struct {
+	Site                  string
+	Reference             string
+	IP                    string
+	MACAddress            string
+	PrivateNetworkCapable string
+	PrivateNetworkEnabled string
+	PrivateRackID         string
+	CabinetID             string
 }
 
 // IP -
 type IP struct {
-	IP            string
-	ReverseLookup string
-	NullRouted    bool
+	IP               string
+	Gateway          string
+	Version          int
+	NetworkType      string
+	MainIP           bool
+	ReverseLookup    string
+	NullRouted       bool
+	NullLevel        int
+	UnnullingAllowed bool
+}
+
+// GetAddress returns the canonical IP address without its prefix length.
+func (ip *IP) GetAddress() string {
+	address, _ := splitIPPrefix(ip.IP)
+	return address
+}
+
+// GetGateway returns the canonical gateway address of the IP.
+func (ip *IP) GetGateway() string {
+	gateway, _ := splitIPPrefix(ip.Gateway)
+	return gateway
+}
+
+// GetPrefixLength returns the prefix length of the IP, or 0 if it is not known.
+func (ip *IP) GetPrefixLength() int {
+	_, prefixLength := splitIPPrefix(ip.IP)
+	return prefixLength
+}
+
+// ServerIPFilters -
+type ServerIPFilters struct {
+	NetworkType string
+	Version     string
+	NullRouted  string
+}
+
+// PrivateNetwork -
+type PrivateNetwork struct {
+	ID             string
+	Name           string
+	CustomerID     string
+	SalesOrgID     string
+	EquipmentCount int
+	CreatedAt      string
+	UpdatedAt      string
+	Servers        []PrivateNetworkServer
+}
+
+// PrivateNetworkServer -
+type PrivateNetworkServer struct {
+	ID        string
+	LinkSpeed int
+	Status    string
+	Subnet    string
+	VLANID    string
+}
+
+// GetSubnets returns the distinct subnets of the servers of the private network.
+func (privateNetwork *PrivateNetwork) GetSubnets() []string {
+	var subnets []string
+	seen := map[string]bool{}
+
+	for _, server := range privateNetwork.Servers {
+		if server.Subnet != "" && !seen[server.Subnet] {
+			seen[server.Subnet] = true
+			subnets = append(subnets, server.Subnet)
+		}
+	}
+
+	return subnets
+}
+
+// DHCPReservation -
+type DHCPReservation struct {
+	IP     string `json:"ip"`
+	MAC    string `json:"mac"`
+	Sticky bool   `json:"sticky"`
+}
+
+// NullRoute -
+type NullRoute struct {
+	IP                   string
+	NullLevel            int
+	Comment              string
+	NulledAt             string
+	NulledBy             string
+	UnnulledAt           string
+	UnnulledBy           string
+	AutomatedUnnullingAt string
+	TicketID             string
 }
 
 // DHCPLease -
@@ -80,6 +265,24 @@ func (p *PowerInfo) IsPoweredOn() bool {
 	return p.PDU.Status != "off" && p.IPMI.Status != "off"
 }
 
+// HasStatus returns whether IPMI and PDU report the given status ("on" or "off").
+// A status that cannot be determined (e.g. a server without PDU) is ignored, but at
+// least one of them has to report the given status.
+func (p *PowerInfo) HasStatus(status string) bool {
+	matched := false
+	for _, s := range []string{p.IPMI.Status, p.PDU.Status} {
+		switch s {
+		case "unknown", "":
+			continue
+		case status:
+			matched = true
+		default:
+			return false
+		}
+	}
+	return matched
+}
+
 // NetworkInterfaceInfo -
 type NetworkInterfaceInfo struct {
 	Status string
@@ -92,10 +295,18 @@ func (n *NetworkInterfaceInfo) IsOpened() bool {
 
 // NotificationSetting -
 type NotificationSetting struct {
-	ID        string  `json:"id,omitempty"`
-	Frequency string  `json:"frequency"`
-	Threshold float64 `json:"threshold,string"`
-	Unit      string  `json:"unit"`
+	ID                  string  `json:"id,omitempty"`
+	Frequency           string  `json:"frequency"`
+	Threshold           float64 `json:"threshold,string"`
+	Unit                string  `json:"unit"`
+	LastCheckedAt       string  `json:"lastCheckedAt,omitempty"`
+	ThresholdExceededAt string  `json:"thresholdExceededAt,omitempty"`
+}
+
+// DDoSNotificationSetting -
+type DDoSNotificationSetting struct {
+	Nulling   string `json:"nulling"`
+	Scrubbing string `json:"scrubbing"`
 }
 
 // Credential -
@@ -117,6 +328,137 @@ type ControlPanel struct {
 	Name string
 }
 
+// RescueImage -
+type RescueImage struct {
+	ID   string
+	Name string
+}
+
+// FlexibleInt is an integer which the API sometimes encodes as a JSON string.
+type FlexibleInt int64
+
+// UnmarshalJSON -
+func (i *FlexibleInt) UnmarshalJSON(data []byte) error {
+	value := strings.Trim(string(data), `"`)
+
+	if value == "" || value == "null" {
+		*i = 0
+		return nil
+	}
+
+	if n, err := strconv.ParseInt(value, 10, 64); err == nil {
+		*i = FlexibleInt(n)
+		return nil
+	}
+
+	n, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return err
+	}
+	*i = FlexibleInt(n)
+
+	return nil
+}
+
+// HardwareInfo -
+type HardwareInfo struct {
+	ID            string
+	ParserVersion string
+	ScannedAt     string
+	Result        struct {
+		CPU []struct {
+			Description string
+			Vendor      string
+			Slot        string
+			Hz          FlexibleInt
+			Settings    struct {
+				Cores   FlexibleInt
+				Threads FlexibleInt
+			}
+		}
+		Memory []struct {
+			ID           string
+			Description  string
+			SerialNumber string      `json:"serial_number"`
+			SizeBytes    FlexibleInt `json:"size_bytes"`
+			ClockHz      FlexibleInt `json:"clock_hz"`
+		}
+		Disks []struct {
+			ID           string
+			Description  string
+			Vendor       string
+			Product      string
+			SerialNumber string `json:"serial_number"`
+			Size         FlexibleInt
+			Smartctl     struct {
+				OverallHealth string `json:"overall_health"`
+				RPM           string `json:"rpm"`
+			}
+		}
+		Network []struct {
+			LogicalName string `json:"logical_name"`
+			MACAddress  string `json:"mac_address"`
+			Vendor      string
+			Product     string
+			Settings    struct {
+				Speed string
+				Link  string
+			}
+		}
+	}
+}
+
+// Metrics -
+type Metrics struct {
+	Metadata struct {
+		Aggregation string
+		From        string
+		To          string
+		Granularity string
+	} `json:"_metadata"`
+	Metrics struct {
+		UpPublic   MetricSeries `json:"UP_PUBLIC"`
+		DownPublic MetricSeries `json:"DOWN_PUBLIC"`
+	}
+}
+
+// MetricSeries -
+type MetricSeries struct {
+	Unit   string
+	Values []struct {
+		Timestamp string
+		Value     float64
+	}
+}
+
+// Max returns the highest value of the series, or 0 when it is empty.
+func (series *MetricSeries) Max() float64 {
+	var max float64
+	for _, value := range series.Values {
+		if value.Value > max {
+			max = value.Value
+		}
+	}
+	return max
+}
+
+// Sum returns the sum of the values of the series.
+func (series *MetricSeries) Sum() float64 {
+	var sum float64
+	for _, value := range series.Values {
+		sum += value.Value
+	}
+	return sum
+}
+
+// MetricsFilters -
+type MetricsFilters struct {
+	From        string
+	To          string
+	Granularity string
+	Aggregation string
+}
+
 // Payload -
 type Payload map[string]interface{}
 
@@ -252,14 +594,19 @@ func getServer(ctx context.Context, serverID string) (*Server, error) {
 		return nil, NewDecodingError(apiCtx, err)
 	}
 
-	server.NetworkInterfaces.Public.IP = strings.SplitN(server.NetworkInterfaces.Public.IP, "/", 2)[0]
-	server.NetworkInterfaces.RemoteManagement.IP = strings.SplitN(server.NetworkInterfaces.RemoteManagement.IP, "/", 2)[0]
+	server.splitIPPrefixes()
 
 	return &server, nil
 }
 
 func getServerIP(ctx context.Context, serverID string, ip string) (*IP, error) {
 	apiCtx := fmt.Sprintf("getting server %s IP %s", serverID, ip)
+
+	ip, err := normalizeIP(ip)
+	if err != nil {
+		return nil, err
+	}
+
 	url := fmt.Sprintf("%s/bareMetals/v2/servers/%s/ips/%s", leasewebAPIURL, serverID, ip)
 	method := http.MethodGet
 
@@ -284,6 +631,156 @@ func getServerIP(ctx context.Context, serverID string, ip string) (*IP, error) {
 	return &ipData, nil
 }
 
+func getServerIPsBatch(ctx context.Context, serverID string, filters ServerIPFilters, offset int, limit int) ([]IP, error) {
+	apiCtx := fmt.Sprintf("getting server %s IPs list", serverID)
+
+	u, err := url.Parse(fmt.Sprintf("%s/bareMetals/v2/servers/%s/ips", leasewebAPIURL, serverID))
+	if err != nil {
+		return nil, err
+	}
+
+	v := url.Values{}
+
+	if offset >= 0 {
+		v.Set("offset", strconv.Itoa(offset))
+	}
+
+	if limit >= 0 {
+		v.Set("limit", strconv.Itoa(limit))
+	}
+
+	if filters.NetworkType != "" {
+		v.Set("networkType", filters.NetworkType)
+	}
+
+	if filters.Version != "" {
+		v.Set("version", filters.Version)
+	}
+
+	if filters.NullRouted != "" {
+		v.Set("nullRouted", filters.NullRouted)
+	}
+
+	u.RawQuery = v.Encode()
+
+	url := u.String()
+	method := http.MethodGet
+
+	response, err := doAPIRequest(ctx, method, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		err := parseErrorInfo(response.Body, apiCtx)
+		logAPIError(ctx, method, url, err)
+		return nil, err
+	}
+
+	var ipList struct {
+		IPs []IP
+	}
+
+	err = json.NewDecoder(response.Body).Decode(&ipList)
+	if err != nil {
+		return nil, NewDecodingError(apiCtx, err)
+	}
+
+	return ipList.IPs, nil
+}
+
+func getAllServerIPs(ctx context.Context, serverID string, filters ServerIPFilters) ([]IP, error) {
+	var allIPs []IP
+	offset := 0
+	limit := 20
+
+	for {
+		ipsBatch, err := getServerIPsBatch(ctx, serverID, filters, offset, limit)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(ipsBatch) == 0 {
+			break
+		}
+
+		allIPs = append(allIPs, ipsBatch...)
+		offset += limit
+	}
+
+	return allIPs, nil
+}
+
+func getHardwareInfo(ctx context.Context, serverID string) (*HardwareInfo, error) {
+	apiCtx := fmt.Sprintf("getting server %s hardware info", serverID)
+	url := fmt.Sprintf("%s/bareMetals/v2/servers/%s/hardwareInfo", leasewebAPIURL, serverID)
+	method := http.MethodGet
+
+	response, err := doAPIRequest(ctx, method, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		err := parseErrorInfo(response.Body, apiCtx)
+		logAPIError(ctx, method, url, err)
+		return nil, err
+	}
+
+	var hardwareInfo HardwareInfo
+	err = json.NewDecoder(response.Body).Decode(&hardwareInfo)
+	if err != nil {
+		return nil, NewDecodingError(apiCtx, err)
+	}
+
+	return &hardwareInfo, nil
+}
+
+func getServerMetrics(ctx context.Context, serverID string, metricsType string, filters MetricsFilters) (*Metrics, error) {
+	apiCtx := fmt.Sprintf("getting server %s %s metrics", serverID, metricsType)
+
+	u, err := url.Parse(fmt.Sprintf("%s/bareMetals/v2/servers/%s/metrics/%s", leasewebAPIURL, serverID, metricsType))
+	if err != nil {
+		return nil, err
+	}
+
+	v := url.Values{}
+	v.Set("from", filters.From)
+	v.Set("to", filters.To)
+	v.Set("aggregation", filters.Aggregation)
+
+	if filters.Granularity != "" {
+		v.Set("granularity", filters.Granularity)
+	}
+
+	u.RawQuery = v.Encode()
+
+	url := u.String()
+	method := http.MethodGet
+
+	response, err := doAPIRequest(ctx, method, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		err := parseErrorInfo(response.Body, apiCtx)
+		logAPIError(ctx, method, url, err)
+		return nil, err
+	}
+
+	var metrics Metrics
+	err = json.NewDecoder(response.Body).Decode(&metrics)
+	if err != nil {
+		return nil, NewDecodingError(apiCtx, err)
+	}
+
+	return &metrics, nil
+}
+
 func getServerLease(ctx context.Context, serverID string) (*DHCPLease, error) {
 	apiCtx := fmt.Sprintf("getting server %s lease", serverID)
 	url := fmt.Sprintf("%s/bareMetals/v2/servers/%s/leases", leasewebAPIURL, serverID)
@@ -396,8 +893,13 @@ func updateReference(ctx context.Context, serverID string, reference string) err
 func updateReverseLookup(ctx context.Context, serverID string, ip string, reverseLookup string) error {
 	apiCtx := fmt.Sprintf("updating server %s reverse lookup for IP %s", serverID, ip)
 
+	ip, err := normalizeIP(ip)
+	if err != nil {
+		return err
+	}
+
 	requestBody := new(bytes.Buffer)
-	err := json.NewEncoder(requestBody).Encode(struct {
+	err = json.NewEncoder(requestBody).Encode(struct {
 		ReverseLookup string `json:"reverseLookup"`
 	}{
 		ReverseLookup: reverseLookup,
@@ -464,6 +966,26 @@ func powerOffServer(ctx context.Context, serverID string) error {
 	return nil
 }
 
+func powerCycleServer(ctx context.Context, serverID string) error {
+	apiCtx := fmt.Sprintf("power cycling server %s", serverID)
+	url := fmt.Sprintf("%s/bareMetals/v2/servers/%s/powerCycle", leasewebAPIURL, serverID)
+	method := http.MethodPost
+
+	response, err := doAPIRequest(ctx, method, url, nil)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusAccepted {
+		err := parseErrorInfo(response.Body, apiCtx)
+		logAPIError(ctx, method, url, err)
+		return err
+	}
+
+	return nil
+}
+
 func addDHCPLease(ctx context.Context, serverID string, bootfile string) error {
 	apiCtx := fmt.Sprintf("adding server %s lease", serverID)
 
@@ -555,9 +1077,9 @@ func closeNetworkInterface(ctx context.Context, serverID string, networkType str
 	return nil
 }
 
-func nullIP(ctx context.Context, serverID string, ip string) error {
-	apiCtx := fmt.Sprintf("nulling server %s IP %s", serverID, ip)
-	url := fmt.Sprintf("%s/bareMetals/v2/servers/%s/ips/%s/null", leasewebAPIURL, serverID, ip)
+func openAllNetworkInterfaces(ctx context.Context, serverID string) error {
+	apiCtx := fmt.Sprintf("opening server %s network interfaces", serverID)
+	url := fmt.Sprintf("%s/bareMetals/v2/servers/%s/networkInterfaces/open", leasewebAPIURL, serverID)
 	method := http.MethodPost
 
 	response, err := doAPIRequest(ctx, method, url, nil)
@@ -566,7 +1088,7 @@ func nullIP(ctx context.Context, serverID string, ip string) error {
 	}
 	defer response.Body.Close()
 
-	if response.StatusCode != http.StatusAccepted {
+	if response.StatusCode != http.StatusNoContent {
 		err := parseErrorInfo(response.Body, apiCtx)
 		logAPIError(ctx, method, url, err)
 		return err
@@ -575,9 +1097,9 @@ func nullIP(ctx context.Context, serverID string, ip string) error {
 	return nil
 }
 
-func unnullIP(ctx context.Context, serverID string, ip string) error {
-	apiCtx := fmt.Sprintf("unnulling server %s IP %s", serverID, ip)
-	url := fmt.Sprintf("%s/bareMetals/v2/servers/%s/ips/%s/unnull", leasewebAPIURL, serverID, ip)
+func closeAllNetworkInterfaces(ctx context.Context, serverID string) error {
+	apiCtx := fmt.Sprintf("closing server %s network interfaces", serverID)
+	url := fmt.Sprintf("%s/bareMetals/v2/servers/%s/networkInterfaces/close", leasewebAPIURL, serverID)
 	method := http.MethodPost
 
 	response, err := doAPIRequest(ctx, method, url, nil)
@@ -586,7 +1108,7 @@ func unnullIP(ctx context.Context, serverID string, ip string) error {
 	}
 	defer response.Body.Close()
 
-	if response.StatusCode != http.StatusAccepted {
+	if response.StatusCode != http.StatusNoContent {
 		err := parseErrorInfo(response.Body, apiCtx)
 		logAPIError(ctx, method, url, err)
 		return err
@@ -595,42 +1117,649 @@ func unnullIP(ctx context.Context, serverID string, ip string) error {
 	return nil
 }
 
-func createDedicatedServerNotificationSetting(ctx context.Context, serverID string, notificationType string, notificationSetting *NotificationSetting) (*NotificationSetting, error) {
-	apiCtx := fmt.Sprintf("creating server %s notification setting %s", serverID, notificationType)
+func nullIP(ctx context.Context, serverID string, ip string) error {
+	apiCtx := fmt.Sprintf("nulling server %s IP %s", serverID, ip)
 
-	requestBody := new(bytes.Buffer)
-	err := json.NewEncoder(requestBody).Encode(notificationSetting)
+	ip, err := normalizeIP(ip)
 	if err != nil {
-		return nil, NewEncodingError(apiCtx, err)
+		return err
 	}
 
-	url := fmt.Sprintf("%s/bareMetals/v2/servers/%s/notificationSettings/%s", leasewebAPIURL, serverID, notificationType)
+	url := fmt.Sprintf("%s/bareMetals/v2/servers/%s/ips/%s/null", leasewebAPIURL, serverID, ip)
 	method := http.MethodPost
 
-	response, err := doAPIRequest(ctx, method, url, requestBody)
+	response, err := doAPIRequest(ctx, method, url, nil)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	defer response.Body.Close()
 
-	if response.StatusCode != http.StatusCreated {
+	if response.StatusCode != http.StatusAccepted {
+		err := parseErrorInfo(response.Body, apiCtx)
+		logAPIError(ctx, method, url, err)
+		return err
+	}
+
+	return nil
+}
+
+func unnullIP(ctx context.Context, serverID string, ip string) error {
+	apiCtx := fmt.Sprintf("unnulling server %s IP %s", serverID, ip)
+
+	ip, err := normalizeIP(ip)
+	if err != nil {
+		return err
+	}
+
+	url := fmt.Sprintf("%s/bareMetals/v2/servers/%s/ips/%s/unnull", leasewebAPIURL, serverID, ip)
+	method := http.MethodPost
+
+	response, err := doAPIRequest(ctx, method, url, nil)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusAccepted {
+		err := parseErrorInfo(response.Body, apiCtx)
+		logAPIError(ctx, method, url, err)
+		return err
+	}
+
+	return nil
+}
+
+func createDedicatedServerNotificationSetting(ctx context.Context, serverID string, notificationType string, notificationSetting *NotificationSetting) (*NotificationSetting, error) {
+	apiCtx := fmt.Sprintf("creating server %s notification setting %s", serverID, notificationType)
+
+	requestBody := new(bytes.Buffer)
+	err := json.NewEncoder(requestBody).Encode(notificationSetting)
+	if err != nil {
+		return nil, NewEncodingError(apiCtx, err)
+	}
+
+	url := fmt.Sprintf("%s/bareMetals/v2/servers/%s/notificationSettings/%s", leasewebAPIURL, serverID, notificationType)
+	method := http.MethodPost
+
+	response, err := doAPIRequest(ctx, method, url, requestBody)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusCreated {
+		err := parseErrorInfo(response.Body, apiCtx)
+		logAPIError(ctx, method, url, err)
+		return nil, err
+	}
+
+	var createdNotificationSetting NotificationSetting
+	err = json.NewDecoder(response.Body).Decode(&createdNotificationSetting)
+	if err != nil {
+		return nil, NewDecodingError(apiCtx, err)
+	}
+
+	return &createdNotificationSetting, nil
+}
+
+func getDedicatedServerNotificationSetting(ctx context.Context, serverID string, notificationType string, notificationSettingID string) (*NotificationSetting, error) {
+	var notificationSetting NotificationSetting
+
+	err := doGetDedicatedServerNotificationSetting(ctx, serverID, notificationType, notificationType+"/"+notificationSettingID, &notificationSetting)
+	if err != nil {
+		return nil, err
+	}
+
+	return &notificationSetting, nil
+}
+
+func updateDedicatedServerNotificationSetting(ctx context.Context, serverID string, notificationType string, notificationSettingID string, notificationSetting *NotificationSetting) (*NotificationSetting, error) {
+	var updatedNotificationSetting NotificationSetting
+
+	err := doUpdateDedicatedServerNotificationSetting(ctx, serverID, notificationType, notificationType+"/"+notificationSettingID, notificationSetting, &updatedNotificationSetting)
+	if err != nil {
+		return nil, err
+	}
+
+	return &updatedNotificationSetting, nil
+}
+
+func deleteDedicatedServerNotificationSetting(ctx context.Context, serverID string, notificationType string, notificationSettingID string) error {
+	apiCtx := fmt.Sprintf("deleting server %s notification setting %s", serverID, notificationType)
+	url := fmt.Sprintf("%s/bareMetals/v2/servers/%s/notificationSettings/%s/%s", leasewebAPIURL, serverID, notificationType, notificationSettingID)
+	method := http.MethodDelete
+
+	response, err := doAPIRequest(ctx, method, url, nil)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusNoContent {
+		err := parseErrorInfo(response.Body, apiCtx)
+		logAPIError(ctx, method, url, err)
+		return err
+	}
+
+	return nil
+}
+
+func getDedicatedServerDDoSNotificationSetting(ctx context.Context, serverID string) (*DDoSNotificationSetting, error) {
+	var notificationSetting DDoSNotificationSetting
+
+	err := doGetDedicatedServerNotificationSetting(ctx, serverID, "ddos", "ddos", &notificationSetting)
+	if err != nil {
+		return nil, err
+	}
+
+	return &notificationSetting, nil
+}
+
+func updateDedicatedServerDDoSNotificationSetting(ctx context.Context, serverID string, notificationSetting *DDoSNotificationSetting) error {
+	// the DDoS notification setting is updated without returning it
+	return doUpdateDedicatedServerNotificationSetting(ctx, serverID, "ddos", "ddos", notificationSetting, nil)
+}
+
+// doGetDedicatedServerNotificationSetting decodes the notification setting found at the given path,
+// relative to the notification settings of the server, into notificationSetting.
+func doGetDedicatedServerNotificationSetting(ctx context.Context, serverID string, notificationType string, path string, notificationSetting interface{}) error {
+	apiCtx := fmt.Sprintf("getting server %s notification setting %s", serverID, notificationType)
+	url := fmt.Sprintf("%s/bareMetals/v2/servers/%s/notificationSettings/%s", leasewebAPIURL, serverID, path)
+	method := http.MethodGet
+
+	response, err := doAPIRequest(ctx, method, url, nil)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		err := parseErrorInfo(response.Body, apiCtx)
+		logAPIError(ctx, method, url, err)
+		return err
+	}
+
+	err = json.NewDecoder(response.Body).Decode(notificationSetting)
+	if err != nil {
+		return NewDecodingError(apiCtx, err)
+	}
+
+	return nil
+}
+
+// doUpdateDedicatedServerNotificationSetting updates the notification setting found at the given path,
+// relative to the notification settings of the server. The updated notification setting is decoded into
+// updatedNotificationSetting when the API returns it.
+func doUpdateDedicatedServerNotificationSetting(ctx context.Context, serverID string, notificationType string, path string, notificationSetting interface{}, updatedNotificationSetting interface{}) error {
+	apiCtx := fmt.Sprintf("updating server %s notification setting %s", serverID, notificationType)
+
+	requestBody := new(bytes.Buffer)
+	err := json.NewEncoder(requestBody).Encode(notificationSetting)
+	if err != nil {
+		return NewEncodingError(apiCtx, err)
+	}
+
+	url := fmt.Sprintf("%s/bareMetals/v2/servers/%s/notificationSettings/%s", leasewebAPIURL, serverID, path)
+	method := http.MethodPut
+
+	response, err := doAPIRequest(ctx, method, url, requestBody)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK && response.StatusCode != http.StatusNoContent {
+		err := parseErrorInfo(response.Body, apiCtx)
+		logAPIError(ctx, method, url, err)
+		return err
+	}
+
+	if response.StatusCode == http.StatusNoContent || updatedNotificationSetting == nil {
+		return nil
+	}
+
+	err = json.NewDecoder(response.Body).Decode(updatedNotificationSetting)
+	if err != nil {
+		return NewDecodingError(apiCtx, err)
+	}
+
+	return nil
+}
+
+func getDedicatedServerNotificationSettingsBatch(ctx context.Context, serverID string, notificationType string, offset int, limit int) ([]NotificationSetting, error) {
+	apiCtx := fmt.Sprintf("getting server %s notification settings %s list", serverID, notificationType)
+
+	u, err := url.Parse(fmt.Sprintf("%s/bareMetals/v2/servers/%s/notificationSettings/%s", leasewebAPIURL, serverID, notificationType))
+	if err != nil {
+		return nil, err
+	}
+
+	v := url.Values{}
+
+	if offset >= 0 {
+		v.Set("offset", strconv.Itoa(offset))
+	}
+
+	if limit >= 0 {
+		v.Set("limit", strconv.Itoa(limit))
+	}
+
+	u.RawQuery = v.Encode()
+
+	url := u.String()
+	method := http.MethodGet
+
+	response, err := doAPIRequest(ctx, method, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		err := parseErrorInfo(response.Body, apiCtx)
+		logAPIError(ctx, method, url, err)
+		return nil, err
+	}
+
+	// the list is returned under a key named after the notification type (e.g. bandwidthNotificationSettings)
+	var notificationSettingList map[string]json.RawMessage
+
+	err = json.NewDecoder(response.Body).Decode(&notificationSettingList)
+	if err != nil {
+		return nil, NewDecodingError(apiCtx, err)
+	}
+
+	var notificationSettings []NotificationSetting
+
+	if rawNotificationSettings, ok := notificationSettingList[notificationType+"NotificationSettings"]; ok {
+		err = json.Unmarshal(rawNotificationSettings, &notificationSettings)
+		if err != nil {
+			return nil, NewDecodingError(apiCtx, err)
+		}
+	}
+
+	return notificationSettings, nil
+}
+
+func getAllDedicatedServerNotificationSettings(ctx context.Context, serverID string, notificationType string) ([]NotificationSetting, error) {
+	var allNotificationSettings []NotificationSetting
+	offset := 0
+	limit := 20
+
+	for {
+		notificationSettingsBatch, err := getDedicatedServerNotificationSettingsBatch(ctx, serverID, notificationType, offset, limit)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(notificationSettingsBatch) == 0 {
+			break
+		}
+
+		allNotificationSettings = append(allNotificationSettings, notificationSettingsBatch...)
+		offset += limit
+	}
+
+	return allNotificationSettings, nil
+}
+
+func createDedicatedServerCredential(ctx context.Context, serverID string, credential *Credential) (*Credential, error) {
+	apiCtx := fmt.Sprintf("creating server %s credential %s", serverID, credential.Type)
+
+	requestBody := new(bytes.Buffer)
+	err := json.NewEncoder(requestBody).Encode(credential)
+	if err != nil {
+		return nil, NewEncodingError(apiCtx, err)
+	}
+
+	url := fmt.Sprintf("%s/bareMetals/v2/servers/%s/credentials", leasewebAPIURL, serverID)
+	method := http.MethodPost
+
+	response, err := doAPIRequest(ctx, method, url, requestBody)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		err := parseErrorInfo(response.Body, apiCtx)
+		logAPIError(ctx, method, url, err)
+		return nil, err
+	}
+
+	var createdCredential Credential
+	err = json.NewDecoder(response.Body).Decode(&createdCredential)
+	if err != nil {
+		return nil, NewDecodingError(apiCtx, err)
+	}
+
+	return &createdCredential, nil
+}
+
+func getDedicatedServerCredential(ctx context.Context, serverID string, credentialType string, username string) (*Credential, error) {
+	apiCtx := fmt.Sprintf("getting server %s credential %s", serverID, credentialType)
+	url := fmt.Sprintf("%s/bareMetals/v2/servers/%s/credentials/%s/%s", leasewebAPIURL, serverID, credentialType, username)
+	method := http.MethodGet
+
+	response, err := doAPIRequest(ctx, method, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		err := parseErrorInfo(response.Body, apiCtx)
+		logAPIError(ctx, method, url, err)
+		return nil, err
+	}
+
+	var credential Credential
+	err = json.NewDecoder(response.Body).Decode(&credential)
+	if err != nil {
+		return nil, NewDecodingError(apiCtx, err)
+	}
+
+	return &credential, nil
+}
+
+func updateDedicatedServerCredential(ctx context.Context, serverID string, credential *Credential) (*Credential, error) {
+	apiCtx := fmt.Sprintf("updating server %s credential %s", serverID, credential.Type)
+
+	requestBody := new(bytes.Buffer)
+	err := json.NewEncoder(requestBody).Encode(struct {
+		Password string `json:"password"`
+	}{
+		Password: credential.Password,
+	})
+	if err != nil {
+		return nil, NewEncodingError(apiCtx, err)
+	}
+
+	url := fmt.Sprintf("%s/bareMetals/v2/servers/%s/credentials/%s/%s", leasewebAPIURL, serverID, credential.Type, credential.Username)
+	method := http.MethodPut
+
+	response, err := doAPIRequest(ctx, method, url, requestBody)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		err := parseErrorInfo(response.Body, apiCtx)
+		logAPIError(ctx, method, url, err)
+		return nil, err
+	}
+
+	var updatedCredential Credential
+	err = json.NewDecoder(response.Body).Decode(&updatedCredential)
+	if err != nil {
+		return nil, NewDecodingError(apiCtx, err)
+	}
+
+	return &updatedCredential, nil
+}
+
+func deleteDedicatedServerCredential(ctx context.Context, serverID string, credential *Credential) error {
+	apiCtx := fmt.Sprintf("deleting server %s credential %s", serverID, credential.Type)
+	url := fmt.Sprintf("%s/bareMetals/v2/servers/%s/credentials/%s/%s", leasewebAPIURL, serverID, credential.Type, credential.Username)
+	method := http.MethodDelete
+
+	response, err := doAPIRequest(ctx, method, url, nil)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusNoContent {
+		err := parseErrorInfo(response.Body, apiCtx)
+		logAPIError(ctx, method, url, err)
+		return err
+	}
+
+	return nil
+}
+
+func getDedicatedServerCredentialsBatch(ctx context.Context, serverID string, credentialType string, offset int, limit int) ([]Credential, error) {
+	apiCtx := fmt.Sprintf("getting server %s credentials list", serverID)
+
+	path := fmt.Sprintf("%s/bareMetals/v2/servers/%s/credentials", leasewebAPIURL, serverID)
+	if credentialType != "" {
+		path += "/" + credentialType
+	}
+
+	u, err := url.Parse(path)
+	if err != nil {
+		return nil, err
+	}
+
+	v := url.Values{}
+
+	if offset >= 0 {
+		v.Set("offset", strconv.Itoa(offset))
+	}
+
+	if limit >= 0 {
+		v.Set("limit", strconv.Itoa(limit))
+	}
+
+	u.RawQuery = v.Encode()
+
+	url := u.String()
+	method := http.MethodGet
+
+	response, err := doAPIRequest(ctx, method, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		err := parseErrorInfo(response.Body, apiCtx)
+		logAPIError(ctx, method, url, err)
+		return nil, err
+	}
+
+	var credentialList struct {
+		Credentials []Credential
+	}
+
+	err = json.NewDecoder(response.Body).Decode(&credentialList)
+	if err != nil {
+		return nil, NewDecodingError(apiCtx, err)
+	}
+
+	return credentialList.Credentials, nil
+}
+
+func getAllDedicatedServerCredentials(ctx context.Context, serverID string, credentialType string) ([]Credential, error) {
+	var allCredentials []Credential
+	offset := 0
+	limit := 20
+
+	for {
+		credentialsBatch, err := getDedicatedServerCredentialsBatch(ctx, serverID, credentialType, offset, limit)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(credentialsBatch) == 0 {
+			break
+		}
+
+		allCredentials = append(allCredentials, credentialsBatch...)
+		offset += limit
+	}
+
+	return allCredentials, nil
+}
+
+func getServerNullRouteHistoryBatch(ctx context.Context, serverID string, offset int, limit int) ([]NullRoute, error) {
+	apiCtx := fmt.Sprintf("getting server %s null route history", serverID)
+
+	u, err := url.Parse(fmt.Sprintf("%s/bareMetals/v2/servers/%s/nullRouteHistory", leasewebAPIURL, serverID))
+	if err != nil {
+		return nil, err
+	}
+
+	v := url.Values{}
+
+	if offset >= 0 {
+		v.Set("offset", strconv.Itoa(offset))
+	}
+
+	if limit >= 0 {
+		v.Set("limit", strconv.Itoa(limit))
+	}
+
+	u.RawQuery = v.Encode()
+
+	url := u.String()
+	method := http.MethodGet
+
+	response, err := doAPIRequest(ctx, method, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		err := parseErrorInfo(response.Body, apiCtx)
+		logAPIError(ctx, method, url, err)
+		return nil, err
+	}
+
+	var nullRouteList struct {
+		NullRoutes []NullRoute
+	}
+
+	err = json.NewDecoder(response.Body).Decode(&nullRouteList)
+	if err != nil {
+		return nil, NewDecodingError(apiCtx, err)
+	}
+
+	return nullRouteList.NullRoutes, nil
+}
+
+func getAllServerNullRouteHistory(ctx context.Context, serverID string) ([]NullRoute, error) {
+	var allNullRoutes []NullRoute
+	offset := 0
+	limit := 20
+
+	for {
+		nullRoutesBatch, err := getServerNullRouteHistoryBatch(ctx, serverID, offset, limit)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(nullRoutesBatch) == 0 {
+			break
+		}
+
+		allNullRoutes = append(allNullRoutes, nullRoutesBatch...)
+		offset += limit
+	}
+
+	return allNullRoutes, nil
+}
+
+func addServerToPrivateNetwork(ctx context.Context, serverID string, privateNetworkID string, linkSpeed int) error {
+	apiCtx := fmt.Sprintf("adding server %s to private network %s", serverID, privateNetworkID)
+
+	requestBody := new(bytes.Buffer)
+	err := json.NewEncoder(requestBody).Encode(struct {
+		LinkSpeed int `json:"linkSpeed"`
+	}{
+		LinkSpeed: linkSpeed,
+	})
+	if err != nil {
+		return NewEncodingError(apiCtx, err)
+	}
+
+	url := fmt.Sprintf("%s/bareMetals/v2/privateNetworks/%s/servers/%s", leasewebAPIURL, privateNetworkID, serverID)
+	method := http.MethodPut
+
+	response, err := doAPIRequest(ctx, method, url, requestBody)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusNoContent {
+		err := parseErrorInfo(response.Body, apiCtx)
+		logAPIError(ctx, method, url, err)
+		return err
+	}
+
+	return nil
+}
+
+func removeServerFromPrivateNetwork(ctx context.Context, serverID string, privateNetworkID string) error {
+	apiCtx := fmt.Sprintf("removing server %s from private network %s", serverID, privateNetworkID)
+	url := fmt.Sprintf("%s/bareMetals/v2/privateNetworks/%s/servers/%s", leasewebAPIURL, privateNetworkID, serverID)
+	method := http.MethodDelete
+
+	response, err := doAPIRequest(ctx, method, url, nil)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusAccepted {
 		err := parseErrorInfo(response.Body, apiCtx)
 		logAPIError(ctx, method, url, err)
+		return err
+	}
+
+	return nil
+}
+
+func getPrivateNetwork(ctx context.Context, privateNetworkID string) (*PrivateNetwork, error) {
+	apiCtx := fmt.Sprintf("getting private network %s", privateNetworkID)
+	url := fmt.Sprintf("%s/bareMetals/v2/privateNetworks/%s", leasewebAPIURL, privateNetworkID)
+	method := http.MethodGet
+
+	response, err := doAPIRequest(ctx, method, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		err := parseErrorInfo(response.Body, apiCtx)
+		logAPIError(ctx, method, url, err)
+		return nil, err
+	}
+
+	var privateNetwork PrivateNetwork
+	err = json.NewDecoder(response.Body).Decode(&privateNetwork)
+	if err != nil {
+		return nil, NewDecodingError(apiCtx, err)
+	}
+
+	return &privateNetwork, nil
+}
+
+func getPrivateNetworksBatch(ctx context.Context, offset int, limit int) ([]PrivateNetwork, error) {
+	apiCtx := fmt.Sprintf("getting private networks list")
+
+	u, err := url.Parse(fmt.Sprintf("%s/bareMetals/v2/privateNetworks", leasewebAPIURL))
+	if err != nil {
 		return nil, err
 	}
 
-	var createdNotificationSetting NotificationSetting
-	err = json.NewDecoder(response.Body).Decode(&createdNotificationSetting)
-	if err != nil {
-		return nil, NewDecodingError(apiCtx, err)
+	v := url.Values{}
+
+	if offset >= 0 {
+		v.Set("offset", strconv.Itoa(offset))
+	}
+
+	if limit >= 0 {
+		v.Set("limit", strconv.Itoa(limit))
 	}
 
-	return &createdNotificationSetting, nil
-}
+	u.RawQuery = v.Encode()
 
-func getDedicatedServerNotificationSetting(ctx context.Context, serverID string, notificationType string, notificationSettingID string) (*NotificationSetting, error) {
-	apiCtx := fmt.Sprintf("getting server %s notification setting %s", serverID, notificationType)
-	url := fmt.Sprintf("%s/bareMetals/v2/servers/%s/notificationSettings/%s/%s", leasewebAPIURL, serverID, notificationType, notificationSettingID)
+	url := u.String()
 	method := http.MethodGet
 
 	response, err := doAPIRequest(ctx, method, url, nil)
@@ -645,26 +1774,51 @@ func getDedicatedServerNotificationSetting(ctx context.Context, serverID string,
 		return nil, err
 	}
 
-	var notificationSetting NotificationSetting
-	err = json.NewDecoder(response.Body).Decode(&notificationSetting)
+	var privateNetworkList struct {
+		PrivateNetworks []PrivateNetwork
+	}
+
+	err = json.NewDecoder(response.Body).Decode(&privateNetworkList)
 	if err != nil {
 		return nil, NewDecodingError(apiCtx, err)
 	}
 
-	return &notificationSetting, nil
+	return privateNetworkList.PrivateNetworks, nil
 }
 
-func updateDedicatedServerNotificationSetting(ctx context.Context, serverID string, notificationType string, notificationSettingID string, notificationSetting *NotificationSetting) (*NotificationSetting, error) {
-	apiCtx := fmt.Sprintf("updating server %s notification setting %s", serverID, notificationType)
+func getAllPrivateNetworks(ctx context.Context) ([]PrivateNetwork, error) {
+	var allPrivateNetworks []PrivateNetwork
+	offset := 0
+	limit := 20
+
+	for {
+		privateNetworksBatch, err := getPrivateNetworksBatch(ctx, offset, limit)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(privateNetworksBatch) == 0 {
+			break
+		}
+
+		allPrivateNetworks = append(allPrivateNetworks, privateNetworksBatch...)
+		offset += limit
+	}
+
+	return allPrivateNetworks, nil
+}
+
+func createDHCPReservation(ctx context.Context, privateNetworkID string, reservation *DHCPReservation) (*DHCPReservation, error) {
+	apiCtx := fmt.Sprintf("creating private network %s DHCP reservation %s", privateNetworkID, reservation.IP)
 
 	requestBody := new(bytes.Buffer)
-	err := json.NewEncoder(requestBody).Encode(notificationSetting)
+	err := json.NewEncoder(requestBody).Encode(reservation)
 	if err != nil {
 		return nil, NewEncodingError(apiCtx, err)
 	}
 
-	url := fmt.Sprintf("%s/bareMetals/v2/servers/%s/notificationSettings/%s/%s", leasewebAPIURL, serverID, notificationType, notificationSettingID)
-	method := http.MethodPut
+	url := fmt.Sprintf("%s/bareMetals/v2/privateNetworks/%s/reservations", leasewebAPIURL, privateNetworkID)
+	method := http.MethodPost
 
 	response, err := doAPIRequest(ctx, method, url, requestBody)
 	if err != nil {
@@ -672,24 +1826,24 @@ func updateDedicatedServerNotificationSetting(ctx context.Context, serverID stri
 	}
 	defer response.Body.Close()
 
-	if response.StatusCode != http.StatusOK {
+	if response.StatusCode != http.StatusCreated {
 		err := parseErrorInfo(response.Body, apiCtx)
 		logAPIError(ctx, method, url, err)
 		return nil, err
 	}
 
-	var updatedNotificationSetting NotificationSetting
-	err = json.NewDecoder(response.Body).Decode(&updatedNotificationSetting)
+	var createdReservation DHCPReservation
+	err = json.NewDecoder(response.Body).Decode(&createdReservation)
 	if err != nil {
 		return nil, NewDecodingError(apiCtx, err)
 	}
 
-	return &updatedNotificationSetting, nil
+	return &createdReservation, nil
 }
 
-func deleteDedicatedServerNotificationSetting(ctx context.Context, serverID string, notificationType string, notificationSettingID string) error {
-	apiCtx := fmt.Sprintf("deleting server %s notification setting %s", serverID, notificationType)
-	url := fmt.Sprintf("%s/bareMetals/v2/servers/%s/notificationSettings/%s/%s", leasewebAPIURL, serverID, notificationType, notificationSettingID)
+func deleteDHCPReservation(ctx context.Context, privateNetworkID string, ip string) error {
+	apiCtx := fmt.Sprintf("deleting private network %s DHCP reservation %s", privateNetworkID, ip)
+	url := fmt.Sprintf("%s/bareMetals/v2/privateNetworks/%s/reservations/%s", leasewebAPIURL, privateNetworkID, ip)
 	method := http.MethodDelete
 
 	response, err := doAPIRequest(ctx, method, url, nil)
@@ -707,42 +1861,27 @@ func deleteDedicatedServerNotificationSetting(ctx context.Context, serverID stri
 	return nil
 }
 
-func createDedicatedServerCredential(ctx context.Context, serverID string, credential *Credential) (*Credential, error) {
-	apiCtx := fmt.Sprintf("creating server %s credential %s", serverID, credential.Type)
-
-	requestBody := new(bytes.Buffer)
-	err := json.NewEncoder(requestBody).Encode(credential)
-	if err != nil {
-		return nil, NewEncodingError(apiCtx, err)
-	}
+func getDHCPReservationsBatch(ctx context.Context, privateNetworkID string, offset int, limit int) ([]DHCPReservation, error) {
+	apiCtx := fmt.Sprintf("getting private network %s DHCP reservations list", privateNetworkID)
 
-	url := fmt.Sprintf("%s/bareMetals/v2/servers/%s/credentials", leasewebAPIURL, serverID)
-	method := http.MethodPost
-
-	response, err := doAPIRequest(ctx, method, url, requestBody)
+	u, err := url.Parse(fmt.Sprintf("%s/bareMetals/v2/privateNetworks/%s/reservations", leasewebAPIURL, privateNetworkID))
 	if err != nil {
 		return nil, err
 	}
-	defer response.Body.Close()
 
-	if response.StatusCode != http.StatusOK {
-		err := parseErrorInfo(response.Body, apiCtx)
-		logAPIError(ctx, method, url, err)
-		return nil, err
+	v := url.Values{}
+
+	if offset >= 0 {
+		v.Set("offset", strconv.Itoa(offset))
 	}
 
-	var createdCredential Credential
-	err = json.NewDecoder(response.Body).Decode(&createdCredential)
-	if err != nil {
-		return nil, NewDecodingError(apiCtx, err)
+	if limit >= 0 {
+		v.Set("limit", strconv.Itoa(limit))
 	}
 
-	return &createdCredential, nil
-}
+	u.RawQuery = v.Encode()
 
-func getDedicatedServerCredential(ctx context.Context, serverID string, credentialType string, username string) (*Credential, error) {
-	apiCtx := fmt.Sprintf("getting server %s credential %s", serverID, credentialType)
-	url := fmt.Sprintf("%s/bareMetals/v2/servers/%s/credentials/%s/%s", leasewebAPIURL, serverID, credentialType, username)
+	url := u.String()
 	method := http.MethodGet
 
 	response, err := doAPIRequest(ctx, method, url, nil)
@@ -757,32 +1896,46 @@ func getDedicatedServerCredential(ctx context.Context, serverID string, credenti
 		return nil, err
 	}
 
-	var credential Credential
-	err = json.NewDecoder(response.Body).Decode(&credential)
+	var reservationList struct {
+		Reservations []DHCPReservation
+	}
+
+	err = json.NewDecoder(response.Body).Decode(&reservationList)
 	if err != nil {
 		return nil, NewDecodingError(apiCtx, err)
 	}
 
-	return &credential, nil
+	return reservationList.Reservations, nil
 }
 
-func updateDedicatedServerCredential(ctx context.Context, serverID string, credential *Credential) (*Credential, error) {
-	apiCtx := fmt.Sprintf("updating server %s credential %s", serverID, credential.Type)
+func getAllDHCPReservations(ctx context.Context, privateNetworkID string) ([]DHCPReservation, error) {
+	var allReservations []DHCPReservation
+	offset := 0
+	limit := 20
 
-	requestBody := new(bytes.Buffer)
-	err := json.NewEncoder(requestBody).Encode(struct {
-		Password string `json:"password"`
-	}{
-		Password: credential.Password,
-	})
-	if err != nil {
-		return nil, NewEncodingError(apiCtx, err)
+	for {
+		reservationsBatch, err := getDHCPReservationsBatch(ctx, privateNetworkID, offset, limit)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(reservationsBatch) == 0 {
+			break
+		}
+
+		allReservations = append(allReservations, reservationsBatch...)
+		offset += limit
 	}
 
-	url := fmt.Sprintf("%s/bareMetals/v2/servers/%s/credentials/%s/%s", leasewebAPIURL, serverID, credential.Type, credential.Username)
-	method := http.MethodPut
+	return allReservations, nil
+}
 
-	response, err := doAPIRequest(ctx, method, url, requestBody)
+func getOperatingSystems(ctx context.Context) ([]OperatingSystem, error) {
+	apiCtx := fmt.Sprintf("getting operating systems")
+	url := fmt.Sprintf("%s/bareMetals/v2/operatingSystems", leasewebAPIURL)
+	method := http.MethodGet
+
+	response, err := doAPIRequest(ctx, method, url, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -794,38 +1947,24 @@ func updateDedicatedServerCredential(ctx context.Context, serverID string, crede
 		return nil, err
 	}
 
-	var updatedCredential Credential
-	err = json.NewDecoder(response.Body).Decode(&updatedCredential)
-	if err != nil {
-		return nil, NewDecodingError(apiCtx, err)
+	var operatingSystems struct {
+		OperatingSystems []OperatingSystem
 	}
 
-	return &updatedCredential, nil
-}
-
-func deleteDedicatedServerCredential(ctx context.Context, serverID string, credential *Credential) error {
-	apiCtx := fmt.Sprintf("deleting server %s credential %s", serverID, credential.Type)
-	url := fmt.Sprintf("%s/bareMetals/v2/servers/%s/credentials/%s/%s", leasewebAPIURL, serverID, credential.Type, credential.Username)
-	method := http.MethodDelete
-
-	response, err := doAPIRequest(ctx, method, url, nil)
+	err = json.NewDecoder(response.Body).Decode(&operatingSystems)
 	if err != nil {
-		return err
+		return nil, NewDecodingError(apiCtx, err)
 	}
-	defer response.Body.Close()
 
-	if response.StatusCode != http.StatusNoContent {
-		err := parseErrorInfo(response.Body, apiCtx)
-		logAPIError(ctx, method, url, err)
-		return err
-	}
+	// to be exact we would need to support pagination by checking the metadata and make multiple requests if needed
+	// but with the default offset and limit values we already get the full list at the moment
 
-	return nil
+	return operatingSystems.OperatingSystems, nil
 }
 
-func getOperatingSystems(ctx context.Context) ([]OperatingSystem, error) {
-	apiCtx := fmt.Sprintf("getting operating systems")
-	url := fmt.Sprintf("%s/bareMetals/v2/operatingSystems", leasewebAPIURL)
+func getRescueImages(ctx context.Context) ([]RescueImage, error) {
+	apiCtx := fmt.Sprintf("getting rescue images")
+	url := fmt.Sprintf("%s/bareMetals/v2/rescueImages", leasewebAPIURL)
 	method := http.MethodGet
 
 	response, err := doAPIRequest(ctx, method, url, nil)
@@ -840,19 +1979,16 @@ func getOperatingSystems(ctx context.Context) ([]OperatingSystem, error) {
 		return nil, err
 	}
 
-	var operatingSystems struct {
-		OperatingSystems []OperatingSystem
+	var rescueImages struct {
+		RescueImages []RescueImage
 	}
 
-	err = json.NewDecoder(response.Body).Decode(&operatingSystems)
+	err = json.NewDecoder(response.Body).Decode(&rescueImages)
 	if err != nil {
 		return nil, NewDecodingError(apiCtx, err)
 	}
 
-	// to be exact we would need to support pagination by checking the metadata and make multiple requests if needed
-	// but with the default offset and limit values we already get the full list at the moment
-
-	return operatingSystems.OperatingSystems, nil
+	return rescueImages.RescueImages, nil
 }
 
 func getControlPanels(ctx context.Context, operatingSystemID string) ([]ControlPanel, error) {
@@ -930,6 +2066,108 @@ func launchInstallationJob(ctx context.Context, serverID string, payload *Payloa
 	return &installationJob, nil
 }
 
+func launchRescueModeJob(ctx context.Context, serverID string, payload *Payload) (*Job, error) {
+	apiCtx := fmt.Sprintf("launching rescue mode job for server %s", serverID)
+
+	requestBody := new(bytes.Buffer)
+	err := json.NewEncoder(requestBody).Encode(payload)
+	if err != nil {
+		return nil, NewEncodingError(apiCtx, err)
+	}
+
+	url := fmt.Sprintf("%s/bareMetals/v2/servers/%s/rescueMode", leasewebAPIURL, serverID)
+	method := http.MethodPost
+
+	response, err := doAPIRequest(ctx, method, url, requestBody)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusAccepted {
+		err := parseErrorInfo(response.Body, apiCtx)
+		logAPIError(ctx, method, url, err)
+		return nil, err
+	}
+
+	var rescueModeJob Job
+
+	err = json.NewDecoder(response.Body).Decode(&rescueModeJob)
+	if err != nil {
+		return nil, NewDecodingError(apiCtx, err)
+	}
+
+	return &rescueModeJob, nil
+}
+
+func launchHardwareScanJob(ctx context.Context, serverID string, payload *Payload) (*Job, error) {
+	apiCtx := fmt.Sprintf("launching hardware scan job for server %s", serverID)
+
+	requestBody := new(bytes.Buffer)
+	err := json.NewEncoder(requestBody).Encode(payload)
+	if err != nil {
+		return nil, NewEncodingError(apiCtx, err)
+	}
+
+	url := fmt.Sprintf("%s/bareMetals/v2/servers/%s/hardwareScan", leasewebAPIURL, serverID)
+	method := http.MethodPost
+
+	response, err := doAPIRequest(ctx, method, url, requestBody)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusAccepted {
+		err := parseErrorInfo(response.Body, apiCtx)
+		logAPIError(ctx, method, url, err)
+		return nil, err
+	}
+
+	var hardwareScanJob Job
+
+	err = json.NewDecoder(response.Body).Decode(&hardwareScanJob)
+	if err != nil {
+		return nil, NewDecodingError(apiCtx, err)
+	}
+
+	return &hardwareScanJob, nil
+}
+
+func launchIPMIResetJob(ctx context.Context, serverID string, payload *Payload) (*Job, error) {
+	apiCtx := fmt.Sprintf("launching IPMI reset job for server %s", serverID)
+
+	requestBody := new(bytes.Buffer)
+	err := json.NewEncoder(requestBody).Encode(payload)
+	if err != nil {
+		return nil, NewEncodingError(apiCtx, err)
+	}
+
+	url := fmt.Sprintf("%s/bareMetals/v2/servers/%s/ipmiReset", leasewebAPIURL, serverID)
+	method := http.MethodPost
+
+	response, err := doAPIRequest(ctx, method, url, requestBody)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusAccepted {
+		err := parseErrorInfo(response.Body, apiCtx)
+		logAPIError(ctx, method, url, err)
+		return nil, err
+	}
+
+	var ipmiResetJob Job
+
+	err = json.NewDecoder(response.Body).Decode(&ipmiResetJob)
+	if err != nil {
+		return nil, NewDecodingError(apiCtx, err)
+	}
+
+	return &ipmiResetJob, nil
+}
+
 func getLatestInstallationJob(ctx context.Context, serverID string) (*Job, error) {
 	apiCtx := fmt.Sprintf("getting latest installation job for server %s", serverID)
 
@@ -996,7 +2234,7 @@ func getJob(ctx context.Context, serverID string, jobUUID string) (*Job, error)
 	return &job, nil
 }
 
-func getServersBatch(ctx context.Context, offset int, limit int, site string) ([]Server, error) {
+func getServersBatch(ctx context.Context, filters ServerFilters, offset int, limit int) ([]Server, error) {
 	apiCtx := fmt.Sprintf("getting servers list")
 
 	u, err := url.Parse(fmt.Sprintf("%s/bareMetals/v2/servers", leasewebAPIURL))
@@ -1014,8 +2252,36 @@ func getServersBatch(ctx context.Context, offset int, limit int, site string) ([
 		v.Set("limit", strconv.Itoa(limit))
 	}
 
-	if site != "" {
-		v.Set("site", site)
+	if filters.Site != "" {
+		v.Set("site", filters.Site)
+	}
+
+	if filters.Reference != "" {
+		v.Set("reference", filters.Reference)
+	}
+
+	if filters.IP != "" {
+		v.Set("ip", filters.IP)
+	}
+
+	if filters.MACAddress != "" {
+		v.Set("macAddress", filters.MACAddress)
+	}
+
+	if filters.PrivateNetworkCapable != "" {
+		v.Set("privateNetworkCapable", filters.PrivateNetworkCapable)
+	}
+
+	if filters.PrivateNetworkEnabled != "" {
+		v.Set("privateNetworkEnabled", filters.PrivateNetworkEnabled)
+	}
+
+	if filters.PrivateRackID != "" {
+		v.Set("privateRackId", filters.PrivateRackID)
+	}
+
+	if filters.CabinetID != "" {
+		v.Set("cabinetId", filters.CabinetID)
 	}
 
 	u.RawQuery = v.Encode()
@@ -1044,16 +2310,20 @@ func getServersBatch(ctx context.Context, offset int, limit int, site string) ([
 		return nil, NewDecodingError(apiCtx, err)
 	}
 
+	for i := range serverList.Servers {
+		serverList.Servers[i].splitIPPrefixes()
+	}
+
 	return serverList.Servers, nil
 }
 
-func getAllServers(ctx context.Context, site string) ([]Server, error) {
+func getAllServers(ctx context.Context, filters ServerFilters) ([]Server, error) {
 	var allServers []Server
 	offset := 0
 	limit := 20
 
 	for {
-		serversBatch, err := getServersBatch(ctx, offset, limit, site)
+		serversBatch, err := getServersBatch(ctx, filters, offset, limit)
 		if err != nil {
 			return nil, err
 		}
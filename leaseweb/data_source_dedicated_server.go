@@ -0,0 +1,138 @@
+package leaseweb
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceDedicatedServer() *schema.Resource {
+	lookupAttributes := []string{"id", "reference", "ip", "mac_address"}
+
+	dataSourceSchema := dedicatedServerSchema()
+
+	dataSourceSchema["id"] = &schema.Schema{
+		Description:  "The ID of the dedicated server.",
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ExactlyOneOf: lookupAttributes,
+	}
+
+	dataSourceSchema["reference"] = &schema.Schema{
+		Description:  "The reference of the dedicated server.",
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ExactlyOneOf: lookupAttributes,
+	}
+
+	dataSourceSchema["ip"] = &schema.Schema{
+		Description:  "An IP assigned to the dedicated server, without prefix length.",
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validateIPAddress,
+		ExactlyOneOf: lookupAttributes,
+	}
+
+	dataSourceSchema["mac_address"] = &schema.Schema{
+		Description:  "The MAC address of a network interface of the dedicated server.",
+		Type:         schema.TypeString,
+		Optional:     true,
+		ExactlyOneOf: lookupAttributes,
+	}
+
+	return &schema.Resource{
+		Description: `
+The ` + "`dedicated_server`" + ` data source allows access to the details of a dedicated server,
+looked up by ` + "`id`" + `, ` + "`reference`" + `, ` + "`ip`" + ` or ` + "`mac_address`" + `.
+Exactly one dedicated server has to match the lookup.
+`,
+		ReadContext: dataSourceDedicatedServerRead,
+		Schema:      dataSourceSchema,
+	}
+}
+
+func dataSourceDedicatedServerRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	serverID := d.Get("id").(string)
+
+	if serverID == "" {
+		var err error
+		serverID, err = lookupDedicatedServerID(ctx, d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	server, err := getServer(ctx, serverID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := setDedicatedServer(d, server); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(server.ID)
+
+	return diags
+}
+
+func lookupDedicatedServerID(ctx context.Context, d *schema.ResourceData) (string, error) {
+	var filters ServerFilters
+	var lookup string
+
+	if reference, ok := d.GetOk("reference"); ok {
+		filters.Reference = reference.(string)
+		lookup = fmt.Sprintf("reference %q", filters.Reference)
+	}
+
+	if ip, ok := d.GetOk("ip"); ok {
+		ipAddress, err := normalizeIP(ip.(string))
+		if err != nil {
+			return "", err
+		}
+		filters.IP = ipAddress
+		lookup = fmt.Sprintf("IP %s", filters.IP)
+	}
+
+	if macAddress, ok := d.GetOk("mac_address"); ok {
+		filters.MACAddress = macAddress.(string)
+		lookup = fmt.Sprintf("MAC address %s", filters.MACAddress)
+	}
+
+	servers, err := getAllServers(ctx, filters)
+	if err != nil {
+		return "", err
+	}
+
+	// the API matches references partially, only keep the exact ones
+	if filters.Reference != "" {
+		var matchingServers []Server
+		for _, server := range servers {
+			if server.Contract.Reference == filters.Reference {
+				matchingServers = append(matchingServers, server)
+			}
+		}
+		servers = matchingServers
+	}
+
+	switch len(servers) {
+	case 0:
+		return "", fmt.Errorf("no dedicated server found with %s", lookup)
+	case 1:
+		return servers[0].ID, nil
+	}
+
+	serverIDs := make([]string, len(servers))
+	for i, server := range servers {
+		serverIDs[i] = server.ID
+	}
+
+	return "", fmt.Errorf("%d dedicated servers found with %s (%s), the lookup has to match exactly one", len(servers), lookup, strings.Join(serverIDs, ", "))
+}
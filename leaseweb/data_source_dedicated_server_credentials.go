@@ -0,0 +1,112 @@
+package leaseweb
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceDedicatedServerCredentials() *schema.Resource {
+	return &schema.Resource{
+		Description: `
+The ` + "`dedicated_server_credentials`" + ` data source allows access to the list of
+credentials stored for a dedicated server.
+`,
+		ReadContext: dataSourceDedicatedServerCredentialsRead,
+		Schema: map[string]*schema.Schema{
+			"dedicated_server_id": {
+				Description: "The ID of the dedicated server.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"type": {
+				Description: `
+Filter the list of credentials by type.
+Can be either ` + "`OPERATING_SYSTEM`" + `, ` + "`CONTROL_PANEL`" + `, ` + "`REMOTE_MANAGEMENT`" + `, ` + "`RESCUE_MODE`" + `, ` + "`SWITCH`" + `, ` + "`PDU`" + `, ` + "`FIREWALL`" + ` or ` + "`LOAD_BALANCER`" + `.
+`,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"OPERATING_SYSTEM", "CONTROL_PANEL", "REMOTE_MANAGEMENT", "RESCUE_MODE", "SWITCH", "PDU", "FIREWALL", "LOAD_BALANCER"}, false),
+			},
+			"include_passwords": {
+				Description: `
+Whether to fetch the password of every credential as well.
+This requires one extra API request per credential and stores the passwords in the state.
+`,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"credentials": {
+				Description: "List of the credentials of the dedicated server.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Description: "The type of the credential.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"username": {
+							Description: "The username of the credential.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"password": {
+							Description: "The password of the credential, only set when `include_passwords` is enabled.",
+							Type:        schema.TypeString,
+							Computed:    true,
+							Sensitive:   true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDedicatedServerCredentialsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	serverID := d.Get("dedicated_server_id").(string)
+	credentialType := d.Get("type").(string)
+	includePasswords := d.Get("include_passwords").(bool)
+
+	credentials, err := getAllDedicatedServerCredentials(ctx, serverID, credentialType)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	credentialsList := make([]map[string]interface{}, len(credentials))
+
+	for i, credential := range credentials {
+		if includePasswords {
+			credentialWithPassword, err := getDedicatedServerCredential(ctx, serverID, credential.Type, credential.Username)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			credential.Password = credentialWithPassword.Password
+		}
+
+		credentialsList[i] = map[string]interface{}{
+			"type":     credential.Type,
+			"username": credential.Username,
+			"password": credential.Password,
+		}
+	}
+
+	if err := d.Set("credentials", credentialsList); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if credentialType != "" {
+		d.SetId(serverID + ":" + credentialType)
+	} else {
+		d.SetId(serverID)
+	}
+
+	return diags
+}
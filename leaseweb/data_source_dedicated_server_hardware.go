@@ -0,0 +1,50 @@
+package leaseweb
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceDedicatedServerHardware() *schema.Resource {
+	dataSourceSchema := map[string]*schema.Schema{
+		"dedicated_server_id": {
+			Description: "The ID of the dedicated server.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+	}
+
+	for k, v := range hardwareInfoSchema() {
+		dataSourceSchema[k] = v
+	}
+
+	return &schema.Resource{
+		Description: `
+The ` + "`dedicated_server_hardware`" + ` data source allows access to the hardware of a
+dedicated server, as found by its latest hardware scan.
+`,
+		ReadContext: dataSourceDedicatedServerHardwareRead,
+		Schema:      dataSourceSchema,
+	}
+}
+
+func dataSourceDedicatedServerHardwareRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	serverID := d.Get("dedicated_server_id").(string)
+
+	hardwareInfo, err := getHardwareInfo(ctx, serverID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := setHardwareInfo(d, hardwareInfo); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(serverID)
+
+	return diags
+}
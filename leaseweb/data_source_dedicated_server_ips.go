@@ -0,0 +1,149 @@
+package leaseweb
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceDedicatedServerIPs() *schema.Resource {
+	return &schema.Resource{
+		Description: `
+The ` + "`dedicated_server_ips`" + ` data source allows access to the list of
+IPs assigned to a dedicated server.
+`,
+		ReadContext: dataSourceDedicatedServerIPsRead,
+		Schema: map[string]*schema.Schema{
+			"dedicated_server_id": {
+				Description: "The ID of the dedicated server.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"network_type": {
+				Description: `
+Filter the list of IPs by network type.
+Can be either ` + "`PUBLIC`" + `, ` + "`INTERNAL`" + ` or ` + "`REMOTE_MANAGEMENT`" + `.
+`,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"PUBLIC", "INTERNAL", "REMOTE_MANAGEMENT"}, false),
+			},
+			"version": {
+				Description:  "Filter the list of IPs by version, either `4` or `6`.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntInSlice([]int{4, 6}),
+			},
+			"null_routed": {
+				Description: "Filter the list of IPs by null routed status.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			"ips": {
+				Description: "List of the IPs assigned to the dedicated server.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ip": {
+							Description: "The IP address, without prefix length.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"prefix_length": {
+							Description: "The prefix length of the subnet the IP belongs to.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"gateway": {
+							Description: "The gateway of the IP.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"version": {
+							Description: "The version of the IP.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"network_type": {
+							Description: "The type of network the IP belongs to.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"main_ip": {
+							Description: "Whether the IP is the main IP of the dedicated server.",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"reverse_lookup": {
+							Description: "The reverse lookup associated with the IP.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"null_routed": {
+							Description: "Whether the IP is null routed or not.",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"null_level": {
+							Description: "The null level of the IP.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDedicatedServerIPsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	serverID := d.Get("dedicated_server_id").(string)
+
+	filters := ServerIPFilters{
+		NetworkType: d.Get("network_type").(string),
+	}
+
+	if version := d.Get("version").(int); version != 0 {
+		filters.Version = strconv.Itoa(version)
+	}
+
+	if nullRouted := d.GetRawConfig().GetAttr("null_routed"); !nullRouted.IsNull() {
+		filters.NullRouted = strconv.FormatBool(nullRouted.True())
+	}
+
+	ips, err := getAllServerIPs(ctx, serverID, filters)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ipsList := make([]map[string]interface{}, len(ips))
+
+	for i, ip := range ips {
+		ipsList[i] = map[string]interface{}{
+			"ip":             ip.GetAddress(),
+			"prefix_length":  ip.GetPrefixLength(),
+			"gateway":        ip.GetGateway(),
+			"version":        ip.Version,
+			"network_type":   ip.NetworkType,
+			"main_ip":        ip.MainIP,
+			"reverse_lookup": ip.ReverseLookup,
+			"null_routed":    ip.NullRouted,
+			"null_level":     ip.NullLevel,
+		}
+	}
+
+	if err := d.Set("ips", ipsList); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strings.Join([]string{serverID, filters.NetworkType, filters.Version, filters.NullRouted}, ":"))
+
+	return diags
+}
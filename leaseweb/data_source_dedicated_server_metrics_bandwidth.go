@@ -0,0 +1,47 @@
+package leaseweb
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceDedicatedServerMetricsBandwidth() *schema.Resource {
+	dataSourceSchema := metricsSchema([]string{"AVG", "95TH"})
+
+	dataSourceSchema["up_max"] = &schema.Schema{
+		Description: "The highest value of the `up` series.",
+		Type:        schema.TypeFloat,
+		Computed:    true,
+	}
+
+	dataSourceSchema["down_max"] = &schema.Schema{
+		Description: "The highest value of the `down` series.",
+		Type:        schema.TypeFloat,
+		Computed:    true,
+	}
+
+	return &schema.Resource{
+		Description: `
+The ` + "`dedicated_server_metrics_bandwidth`" + ` data source allows access to the public bandwidth
+usage of a dedicated server over a period.
+`,
+		ReadContext: dataSourceDedicatedServerMetricsBandwidthRead,
+		Schema:      dataSourceSchema,
+	}
+}
+
+func dataSourceDedicatedServerMetricsBandwidthRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	metrics, err := readServerMetrics(ctx, d, "bandwidth")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("up_max", metrics.Metrics.UpPublic.Max())
+	d.Set("down_max", metrics.Metrics.DownPublic.Max())
+
+	return diags
+}
@@ -0,0 +1,47 @@
+package leaseweb
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceDedicatedServerMetricsDatatraffic() *schema.Resource {
+	dataSourceSchema := metricsSchema([]string{"SUM"})
+
+	dataSourceSchema["up_total"] = &schema.Schema{
+		Description: "The sum of the values of the `up` series.",
+		Type:        schema.TypeFloat,
+		Computed:    true,
+	}
+
+	dataSourceSchema["down_total"] = &schema.Schema{
+		Description: "The sum of the values of the `down` series.",
+		Type:        schema.TypeFloat,
+		Computed:    true,
+	}
+
+	return &schema.Resource{
+		Description: `
+The ` + "`dedicated_server_metrics_datatraffic`" + ` data source allows access to the public data traffic
+of a dedicated server over a period.
+`,
+		ReadContext: dataSourceDedicatedServerMetricsDatatrafficRead,
+		Schema:      dataSourceSchema,
+	}
+}
+
+func dataSourceDedicatedServerMetricsDatatrafficRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	metrics, err := readServerMetrics(ctx, d, "datatraffic")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("up_total", metrics.Metrics.UpPublic.Sum())
+	d.Set("down_total", metrics.Metrics.DownPublic.Sum())
+
+	return diags
+}
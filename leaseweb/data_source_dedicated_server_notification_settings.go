@@ -0,0 +1,107 @@
+package leaseweb
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceDedicatedServerNotificationSettings() *schema.Resource {
+	return &schema.Resource{
+		Description: `
+The ` + "`dedicated_server_notification_settings`" + ` data source allows access to the list of
+notification settings of a type linked to a dedicated server.
+`,
+		ReadContext: dataSourceDedicatedServerNotificationSettingsRead,
+		Schema: map[string]*schema.Schema{
+			"dedicated_server_id": {
+				Description: "The ID of the dedicated server.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"type": {
+				Description: `
+The type of the notification settings.
+Can be either ` + "`bandwidth`" + ` or ` + "`datatraffic`" + `.
+`,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"bandwidth", "datatraffic"}, false),
+			},
+			"notification_settings": {
+				Description: "List of the notification settings.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "The ID of the notification setting.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"frequency": {
+							Description: "The frequency of the notification.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"threshold": {
+							Description: "The threshold of the notification.",
+							Type:        schema.TypeFloat,
+							Computed:    true,
+						},
+						"unit": {
+							Description: "The unit of the notification.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"last_checked_at": {
+							Description: "The date the threshold was last checked, if ever.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"threshold_exceeded_at": {
+							Description: "The date the threshold was last exceeded, if ever.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDedicatedServerNotificationSettingsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	serverID := d.Get("dedicated_server_id").(string)
+	notificationType := d.Get("type").(string)
+
+	notificationSettings, err := getAllDedicatedServerNotificationSettings(ctx, serverID, notificationType)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	notificationSettingsList := make([]map[string]interface{}, len(notificationSettings))
+
+	for i, notificationSetting := range notificationSettings {
+		notificationSettingsList[i] = map[string]interface{}{
+			"id":                    notificationSetting.ID,
+			"frequency":             notificationSetting.Frequency,
+			"threshold":             notificationSetting.Threshold,
+			"unit":                  notificationSetting.Unit,
+			"last_checked_at":       notificationSetting.LastCheckedAt,
+			"threshold_exceeded_at": notificationSetting.ThresholdExceededAt,
+		}
+	}
+
+	if err := d.Set("notification_settings", notificationSettingsList); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(serverID + ":" + notificationType)
+
+	return diags
+}
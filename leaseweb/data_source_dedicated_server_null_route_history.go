@@ -0,0 +1,116 @@
+package leaseweb
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceDedicatedServerNullRouteHistory() *schema.Resource {
+	return &schema.Resource{
+		Description: `
+The ` + "`dedicated_server_null_route_history`" + ` data source allows access to the past and
+current null routes of the IPs of a dedicated server.
+`,
+		ReadContext: dataSourceDedicatedServerNullRouteHistoryRead,
+		Schema: map[string]*schema.Schema{
+			"dedicated_server_id": {
+				Description: "The ID of the dedicated server.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"null_routes": {
+				Description: "List of the null routes, as returned by the API.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ip": {
+							Description: "The null routed IP, without prefix length.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"null_level": {
+							Description: "The null level of the null route.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"comment": {
+							Description: "The reason of the null route.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"nulled_at": {
+							Description: "The date the IP was null routed.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"nulled_by": {
+							Description: "Who null routed the IP.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"unnulled_at": {
+							Description: "The date the null route was removed, empty when the IP is still null routed.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"unnulled_by": {
+							Description: "Who removed the null route.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"automated_unnulling_at": {
+							Description: "The date the null route is scheduled to be removed automatically, if any.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"ticket_id": {
+							Description: "The ID of the ticket related to the null route, if any.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDedicatedServerNullRouteHistoryRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	serverID := d.Get("dedicated_server_id").(string)
+
+	nullRoutes, err := getAllServerNullRouteHistory(ctx, serverID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	nullRoutesList := make([]map[string]interface{}, len(nullRoutes))
+
+	for i, nullRoute := range nullRoutes {
+		ip, _ := splitIPPrefix(nullRoute.IP)
+
+		nullRoutesList[i] = map[string]interface{}{
+			"ip":                     ip,
+			"null_level":             nullRoute.NullLevel,
+			"comment":                nullRoute.Comment,
+			"nulled_at":              nullRoute.NulledAt,
+			"nulled_by":              nullRoute.NulledBy,
+			"unnulled_at":            nullRoute.UnnulledAt,
+			"unnulled_by":            nullRoute.UnnulledBy,
+			"automated_unnulling_at": nullRoute.AutomatedUnnullingAt,
+			"ticket_id":              nullRoute.TicketID,
+		}
+	}
+
+	if err := d.Set("null_routes", nullRoutesList); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(serverID)
+
+	return diags
+}
@@ -0,0 +1,65 @@
+package leaseweb
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceDedicatedServerRescueImages() *schema.Resource {
+	return &schema.Resource{
+		Description: `
+The ` + "`dedicated_server_rescue_images`" + ` data source allows access to the list of
+rescue images available to boot a dedicated server in rescue mode.
+`,
+		ReadContext: dataSourceDedicatedServerRescueImagesRead,
+		Schema: map[string]*schema.Schema{
+			"names": {
+				Description: "List of the rescue image names.",
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"ids": {
+				Description: "List of the rescue image IDs.",
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDedicatedServerRescueImagesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	rescueImages, err := getRescueImages(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	rescueImagesNames := make(map[string]string)
+	rescueImagesIds := make([]string, len(rescueImages))
+
+	for i, rescueImage := range rescueImages {
+		rescueImagesNames[rescueImage.ID] = rescueImage.Name
+		rescueImagesIds[i] = rescueImage.ID
+	}
+
+	if err := d.Set("names", rescueImagesNames); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("ids", rescueImagesIds); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("rescue_images")
+
+	return diags
+}
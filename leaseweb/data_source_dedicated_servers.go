@@ -2,11 +2,13 @@ package leaseweb
 
 import (
 	"context"
+	"regexp"
 	"strconv"
-	"time"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceDedicatedServers() *schema.Resource {
@@ -25,11 +27,61 @@ dedicated servers available in your account.
 					Type: schema.TypeString,
 				},
 			},
+			"servers": {
+				Description: "List of the dedicated servers available to the account.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: dedicatedServerSchema(),
+				},
+			},
 			"site": {
 				Description: "Filter the list of servers by location site.",
 				Type:        schema.TypeString,
 				Optional:    true,
 			},
+			"reference": {
+				Description: "Filter the list of servers by reference.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"reference_regex": {
+				Description:  "Filter the list of servers by a regular expression their reference has to match.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"ip": {
+				Description:  "Filter the list of servers by an IP assigned to them, without prefix length.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateIPAddress,
+			},
+			"mac_address": {
+				Description: "Filter the list of servers by the MAC address of one of their network interfaces.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"private_network_capable": {
+				Description: "Filter the list of servers by whether they can be added to a private network or not.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			"private_network_enabled": {
+				Description: "Filter the list of servers by whether they are part of a private network or not.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			"private_rack_id": {
+				Description: "Filter the list of servers by private rack ID.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"cabinet_id": {
+				Description: "Filter the list of servers by cabinet ID.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
 		},
 	}
 }
@@ -37,23 +89,79 @@ dedicated servers available in your account.
 func dataSourceDedicatedServersRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 
-	site := d.Get("site").(string)
-	servers, err := getAllServers(ctx, site)
+	filters := ServerFilters{
+		Site:          d.Get("site").(string),
+		Reference:     d.Get("reference").(string),
+		MACAddress:    d.Get("mac_address").(string),
+		PrivateRackID: d.Get("private_rack_id").(string),
+		CabinetID:     d.Get("cabinet_id").(string),
+	}
+
+	if ip := d.Get("ip").(string); ip != "" {
+		ipAddress, err := normalizeIP(ip)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		filters.IP = ipAddress
+	}
+
+	if privateNetworkCapable := d.GetRawConfig().GetAttr("private_network_capable"); !privateNetworkCapable.IsNull() {
+		filters.PrivateNetworkCapable = strconv.FormatBool(privateNetworkCapable.True())
+	}
+
+	if privateNetworkEnabled := d.GetRawConfig().GetAttr("private_network_enabled"); !privateNetworkEnabled.IsNull() {
+		filters.PrivateNetworkEnabled = strconv.FormatBool(privateNetworkEnabled.True())
+	}
+
+	referenceRegex := d.Get("reference_regex").(string)
+
+	servers, err := getAllServers(ctx, filters)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
+	if referenceRegex != "" {
+		re, err := regexp.Compile(referenceRegex)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		var matchingServers []Server
+		for _, server := range servers {
+			if re.MatchString(server.Contract.Reference) {
+				matchingServers = append(matchingServers, server)
+			}
+		}
+		servers = matchingServers
+	}
+
 	serverIds := make([]string, len(servers))
+	serversList := make([]map[string]interface{}, len(servers))
 
 	for i, server := range servers {
 		serverIds[i] = server.ID
+		serversList[i] = flattenDedicatedServer(&servers[i])
 	}
 
 	if err := d.Set("ids", serverIds); err != nil {
 		return diag.FromErr(err)
 	}
 
-	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+	if err := d.Set("servers", serversList); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.Itoa(schema.HashString(strings.Join([]string{
+		filters.Site,
+		filters.Reference,
+		referenceRegex,
+		filters.IP,
+		filters.MACAddress,
+		filters.PrivateNetworkCapable,
+		filters.PrivateNetworkEnabled,
+		filters.PrivateRackID,
+		filters.CabinetID,
+	}, "\n"))))
 
 	return diags
 }
@@ -0,0 +1,80 @@
+package leaseweb
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourcePrivateNetworkDHCPReservations() *schema.Resource {
+	return &schema.Resource{
+		Description: `
+The ` + "`private_network_dhcp_reservations`" + ` data source allows access to the list of
+DHCP reservations of a private network.
+`,
+		ReadContext: dataSourcePrivateNetworkDHCPReservationsRead,
+		Schema: map[string]*schema.Schema{
+			"private_network_id": {
+				Description: "The ID of the private network.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"reservations": {
+				Description: "List of the DHCP reservations.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ip": {
+							Description: "The reserved IP, without prefix length.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"mac_address": {
+							Description: "The MAC address the IP is reserved for.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"sticky": {
+							Description: "Whether the IP stays reserved for the MAC address when it is not in use or not.",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePrivateNetworkDHCPReservationsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	privateNetworkID := d.Get("private_network_id").(string)
+
+	reservations, err := getAllDHCPReservations(ctx, privateNetworkID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	reservationsList := make([]map[string]interface{}, len(reservations))
+
+	for i, reservation := range reservations {
+		ip, _ := splitIPPrefix(reservation.IP)
+
+		reservationsList[i] = map[string]interface{}{
+			"ip":          ip,
+			"mac_address": reservation.MAC,
+			"sticky":      reservation.Sticky,
+		}
+	}
+
+	if err := d.Set("reservations", reservationsList); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(privateNetworkID)
+
+	return diags
+}
@@ -0,0 +1,108 @@
+package leaseweb
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourcePrivateNetworks() *schema.Resource {
+	return &schema.Resource{
+		Description: `
+The ` + "`private_networks`" + ` data source allows access to the list of
+private networks available in your account.
+`,
+		ReadContext: dataSourcePrivateNetworksRead,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "Filter the list of private networks by name.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"ids": {
+				Description: "List of the private network IDs.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"private_networks": {
+				Description: "List of the private networks.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "The ID of the private network.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"name": {
+							Description: "The name of the private network.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"equipment_count": {
+							Description: "The number of equipments in the private network.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"created_at": {
+							Description: "The date the private network was created.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"updated_at": {
+							Description: "The date the private network was last updated.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePrivateNetworksRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	name := d.Get("name").(string)
+
+	privateNetworks, err := getAllPrivateNetworks(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var privateNetworkIds []string
+	var privateNetworksList []map[string]interface{}
+
+	for _, privateNetwork := range privateNetworks {
+		if name != "" && privateNetwork.Name != name {
+			continue
+		}
+
+		privateNetworkIds = append(privateNetworkIds, privateNetwork.ID)
+		privateNetworksList = append(privateNetworksList, map[string]interface{}{
+			"id":              privateNetwork.ID,
+			"name":            privateNetwork.Name,
+			"equipment_count": privateNetwork.EquipmentCount,
+			"created_at":      privateNetwork.CreatedAt,
+			"updated_at":      privateNetwork.UpdatedAt,
+		})
+	}
+
+	if err := d.Set("ids", privateNetworkIds); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("private_networks", privateNetworksList); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("private_networks:" + name)
+
+	return diags
+}
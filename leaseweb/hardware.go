@@ -0,0 +1,274 @@
+package leaseweb
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// hardwareInfoSchema returns the computed attributes describing the hardware of a dedicated server.
+func hardwareInfoSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"scanned_at": {
+			Description: "The date of the hardware scan the information comes from.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"cpus": {
+			Description: "List of the CPUs.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"description": {
+						Description: "The description of the CPU.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"vendor": {
+						Description: "The vendor of the CPU.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"slot": {
+						Description: "The slot of the CPU.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"hz": {
+						Description: "The frequency of the CPU in Hz.",
+						Type:        schema.TypeInt,
+						Computed:    true,
+					},
+					"cores": {
+						Description: "The number of cores of the CPU.",
+						Type:        schema.TypeInt,
+						Computed:    true,
+					},
+					"threads": {
+						Description: "The number of threads of the CPU.",
+						Type:        schema.TypeInt,
+						Computed:    true,
+					},
+				},
+			},
+		},
+		"memory_size_bytes": {
+			Description: "The total size of the memory in bytes.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"memory_modules": {
+			Description: "List of the memory modules.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Description: "The ID of the memory bank.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"description": {
+						Description: "The description of the memory module.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"serial_number": {
+						Description: "The serial number of the memory module.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"size_bytes": {
+						Description: "The size of the memory module in bytes.",
+						Type:        schema.TypeInt,
+						Computed:    true,
+					},
+					"clock_hz": {
+						Description: "The clock of the memory module in Hz.",
+						Type:        schema.TypeInt,
+						Computed:    true,
+					},
+				},
+			},
+		},
+		"disks": {
+			Description: "List of the disks.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Description: "The ID of the disk.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"description": {
+						Description: "The description of the disk, e.g. `ATA Disk`.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"vendor": {
+						Description: "The vendor of the disk.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"product": {
+						Description: "The product name of the disk.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"serial_number": {
+						Description: "The serial number of the disk.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"size_bytes": {
+						Description: "The size of the disk in bytes.",
+						Type:        schema.TypeInt,
+						Computed:    true,
+					},
+					"type": {
+						Description: "The type of the disk, either `HDD`, `SSD` or `NVME`. Empty when it cannot be determined from the scan.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"smart_status": {
+						Description: "The overall SMART health of the disk, e.g. `PASSED`.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+				},
+			},
+		},
+		"network_interfaces": {
+			Description: "List of the network interfaces.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"logical_name": {
+						Description: "The logical name of the network interface, e.g. `eth0`.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"mac_address": {
+						Description: "The MAC address of the network interface.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"vendor": {
+						Description: "The vendor of the network interface.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"product": {
+						Description: "The product name of the network interface.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"speed": {
+						Description: "The speed of the network interface, e.g. `1Gbit/s`.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"link": {
+						Description: "Whether the network interface has a link or not.",
+						Type:        schema.TypeBool,
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func setHardwareInfo(d *schema.ResourceData, hardwareInfo *HardwareInfo) error {
+	cpus := make([]map[string]interface{}, len(hardwareInfo.Result.CPU))
+	for i, cpu := range hardwareInfo.Result.CPU {
+		cpus[i] = map[string]interface{}{
+			"description": cpu.Description,
+			"vendor":      cpu.Vendor,
+			"slot":        cpu.Slot,
+			"hz":          int(cpu.Hz),
+			"cores":       int(cpu.Settings.Cores),
+			"threads":     int(cpu.Settings.Threads),
+		}
+	}
+
+	var memorySize int
+	memoryModules := make([]map[string]interface{}, len(hardwareInfo.Result.Memory))
+	for i, memory := range hardwareInfo.Result.Memory {
+		memorySize += int(memory.SizeBytes)
+		memoryModules[i] = map[string]interface{}{
+			"id":            memory.ID,
+			"description":   memory.Description,
+			"serial_number": memory.SerialNumber,
+			"size_bytes":    int(memory.SizeBytes),
+			"clock_hz":      int(memory.ClockHz),
+		}
+	}
+
+	disks := make([]map[string]interface{}, len(hardwareInfo.Result.Disks))
+	for i, disk := range hardwareInfo.Result.Disks {
+		disks[i] = map[string]interface{}{
+			"id":            disk.ID,
+			"description":   disk.Description,
+			"vendor":        disk.Vendor,
+			"product":       disk.Product,
+			"serial_number": disk.SerialNumber,
+			"size_bytes":    int(disk.Size),
+			"type":          hardwareDiskType(disk.Description, disk.Product, disk.Smartctl.RPM),
+			"smart_status":  disk.Smartctl.OverallHealth,
+		}
+	}
+
+	networkInterfaces := make([]map[string]interface{}, len(hardwareInfo.Result.Network))
+	for i, networkInterface := range hardwareInfo.Result.Network {
+		networkInterfaces[i] = map[string]interface{}{
+			"logical_name": networkInterface.LogicalName,
+			"mac_address":  networkInterface.MACAddress,
+			"vendor":       networkInterface.Vendor,
+			"product":      networkInterface.Product,
+			"speed":        networkInterface.Settings.Speed,
+			"link":         networkInterface.Settings.Link == "yes",
+		}
+	}
+
+	if err := d.Set("scanned_at", hardwareInfo.ScannedAt); err != nil {
+		return err
+	}
+
+	if err := d.Set("cpus", cpus); err != nil {
+		return err
+	}
+
+	if err := d.Set("memory_size_bytes", memorySize); err != nil {
+		return err
+	}
+
+	if err := d.Set("memory_modules", memoryModules); err != nil {
+		return err
+	}
+
+	if err := d.Set("disks", disks); err != nil {
+		return err
+	}
+
+	return d.Set("network_interfaces", networkInterfaces)
+}
+
+// hardwareDiskType derives the type of a disk from its scan: NVMe disks are described as such,
+// smartctl reports the rotation rate of the other disks, "Solid State Device" for SSDs.
+func hardwareDiskType(description string, product string, rotationRate string) string {
+	switch {
+	case strings.Contains(strings.ToLower(description+" "+product), "nvme"):
+		return "NVME"
+	case strings.Contains(strings.ToLower(rotationRate), "solid state"):
+		return "SSD"
+	case strings.Contains(strings.ToLower(rotationRate), "rpm"):
+		return "HDD"
+	}
+
+	return ""
+}
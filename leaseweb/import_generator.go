@@ -0,0 +1,246 @@
+package leaseweb
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/zclconf/go-cty/cty"
+)
+
+var invalidResourceNameChars = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// ImportGeneratorOptions -
+type ImportGeneratorOptions struct {
+	Site             string
+	ReferencePattern *regexp.Regexp
+}
+
+// RunImportGenerator implements the `generate-imports` command of the provider binary.
+// It writes Terraform 1.5 import blocks and the matching resource skeletons for the
+// dedicated servers of the account, their credentials and their notification settings
+// (bandwidth, datatraffic and DDoS).
+func RunImportGenerator(args []string) int {
+	flags := flag.NewFlagSet("generate-imports", flag.ContinueOnError)
+	flags.SetOutput(os.Stderr)
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: terraform-provider-leaseweb generate-imports [options]\n\n")
+		fmt.Fprintf(flags.Output(), "Generate import blocks and resource skeletons for the existing dedicated servers of an account.\n\n")
+		flags.PrintDefaults()
+	}
+
+	apiURL := flags.String("api-url", envOrDefault("LEASEWEB_API_URL", "https://api.leaseweb.com"), "base URL of the API endpoint to use (LEASEWEB_API_URL)")
+	apiToken := flags.String("api-token", os.Getenv("LEASEWEB_API_TOKEN"), "API token to use (LEASEWEB_API_TOKEN)")
+	site := flags.String("site", "", "only generate the servers located in this site")
+	reference := flags.String("reference", "", "only generate the servers whose reference matches this regular expression")
+	output := flags.String("output", "", "file to write the generated configuration to (defaults to stdout)")
+
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+
+	if *apiURL == "" || *apiToken == "" {
+		fmt.Fprintln(os.Stderr, "missing leaseweb API url or token")
+		return 2
+	}
+
+	options := ImportGeneratorOptions{Site: *site}
+
+	if *reference != "" {
+		referencePattern, err := regexp.Compile(*reference)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "invalid reference pattern: %s\n", err)
+			return 2
+		}
+		options.ReferencePattern = referencePattern
+	}
+
+	var w io.Writer = os.Stdout
+
+	if *output != "" {
+		f, err := os.Create(*output)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return 1
+		}
+		defer f.Close()
+		w = f
+	}
+
+	configureAPIClient(*apiURL, *apiToken)
+
+	if err := GenerateImports(context.Background(), w, options); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+
+	return 0
+}
+
+// GenerateImports -
+func GenerateImports(ctx context.Context, w io.Writer, options ImportGeneratorOptions) error {
+	servers, err := getAllServers(ctx, ServerFilters{Site: options.Site})
+	if err != nil {
+		return err
+	}
+
+	file := hclwrite.NewEmptyFile()
+	body := file.Body()
+
+	for i, server := range servers {
+		if options.ReferencePattern != nil && !options.ReferencePattern.MatchString(server.Contract.Reference) {
+			continue
+		}
+
+		serverName := resourceName("server", server.ID)
+		serverAddress := hcl.Traversal{
+			hcl.TraverseRoot{Name: "leaseweb_dedicated_server"},
+			hcl.TraverseAttr{Name: serverName},
+			hcl.TraverseAttr{Name: "id"},
+		}
+
+		if server.Contract.Reference != "" {
+			body.AppendUnstructuredTokens(hclwrite.Tokens{
+				{Type: hclsyntax.TokenComment, Bytes: []byte("# " + server.Contract.Reference + "\n")},
+			})
+		}
+
+		appendImportBlock(body, "leaseweb_dedicated_server", serverName, server.ID)
+		serverBody := body.AppendNewBlock("resource", []string{"leaseweb_dedicated_server", serverName}).Body()
+		if err := setDedicatedServerSkeletonAttributes(ctx, serverBody, &servers[i]); err != nil {
+			return err
+		}
+		body.AppendNewline()
+
+		credentials, err := getAllDedicatedServerCredentials(ctx, server.ID, "")
+		if err != nil {
+			return err
+		}
+
+		for _, credential := range credentials {
+			name := resourceName("server", server.ID, credential.Type, credential.Username)
+			appendImportBlock(body, "leaseweb_dedicated_server_credential", name, server.ID+":"+credential.Type+":"+credential.Username)
+
+			resourceBody := body.AppendNewBlock("resource", []string{"leaseweb_dedicated_server_credential", name}).Body()
+			resourceBody.SetAttributeTraversal("dedicated_server_id", serverAddress)
+			resourceBody.SetAttributeValue("type", cty.StringVal(credential.Type))
+			resourceBody.SetAttributeValue("username", cty.StringVal(credential.Username))
+			resourceBody.AppendUnstructuredTokens(hclwrite.Tokens{
+				{Type: hclsyntax.TokenComment, Bytes: []byte("# password is read on import and kept as long as it and keepers are left unset\n")},
+			})
+			body.AppendNewline()
+		}
+
+		for _, notificationType := range []string{"bandwidth", "datatraffic"} {
+			notificationSettings, err := getAllDedicatedServerNotificationSettings(ctx, server.ID, notificationType)
+			if err != nil {
+				return err
+			}
+
+			for _, notificationSetting := range notificationSettings {
+				resourceType := "leaseweb_dedicated_server_notification_setting_" + notificationType
+				name := resourceName("server", server.ID, notificationType, notificationSetting.ID)
+				appendImportBlock(body, resourceType, name, server.ID+":"+notificationSetting.ID)
+
+				resourceBody := body.AppendNewBlock("resource", []string{resourceType, name}).Body()
+				resourceBody.SetAttributeTraversal("dedicated_server_id", serverAddress)
+				resourceBody.SetAttributeValue("frequency", cty.StringVal(notificationSetting.Frequency))
+				resourceBody.SetAttributeValue("threshold", cty.NumberFloatVal(notificationSetting.Threshold))
+				resourceBody.SetAttributeValue("unit", cty.StringVal(notificationSetting.Unit))
+				body.AppendNewline()
+			}
+		}
+
+		ddosNotificationSetting, err := getDedicatedServerDDoSNotificationSetting(ctx, server.ID)
+		if err != nil {
+			return err
+		}
+
+		ddosName := resourceName("server", server.ID, "ddos")
+		appendImportBlock(body, "leaseweb_dedicated_server_notification_setting_ddos", ddosName, server.ID)
+
+		ddosBody := body.AppendNewBlock("resource", []string{"leaseweb_dedicated_server_notification_setting_ddos", ddosName}).Body()
+		ddosBody.SetAttributeTraversal("dedicated_server_id", serverAddress)
+		ddosBody.SetAttributeValue("nulling", cty.BoolVal(ddosNotificationSetting.Nulling == "ENABLED"))
+		ddosBody.SetAttributeValue("scrubbing", cty.BoolVal(ddosNotificationSetting.Scrubbing == "ENABLED"))
+		body.AppendNewline()
+	}
+
+	_, err = file.WriteTo(w)
+
+	return err
+}
+
+// setDedicatedServerSkeletonAttributes sets the configurable attributes of a dedicated server to their current values,
+// so that applying the generated configuration right after the import does not change anything.
+func setDedicatedServerSkeletonAttributes(ctx context.Context, body *hclwrite.Body, server *Server) error {
+	body.SetAttributeValue("reference", cty.StringVal(server.Contract.Reference))
+
+	if publicIP := server.NetworkInterfaces.Public.IP; publicIP != "" {
+		ip, err := getServerIP(ctx, server.ID, publicIP)
+		if err != nil {
+			return err
+		}
+		if ip.ReverseLookup != "" {
+			body.SetAttributeValue("reverse_lookup", cty.StringVal(ip.ReverseLookup))
+		}
+		body.SetAttributeValue("public_ip_null_routed", cty.BoolVal(ip.NullRouted))
+	}
+
+	lease, err := getServerLease(ctx, server.ID)
+	if err != nil {
+		return err
+	}
+	if bootFile := lease.GetBootfile(); bootFile != "" {
+		body.SetAttributeValue("dhcp_lease", cty.StringVal(bootFile))
+	}
+
+	powerInfo, err := getPowerInfo(ctx, server.ID)
+	if err != nil {
+		return err
+	}
+	body.SetAttributeValue("powered_on", cty.BoolVal(powerInfo.IsPoweredOn()))
+
+	for _, networkInterface := range dedicatedServerNetworkInterfaces {
+		if !networkInterface.exists(server) {
+			continue
+		}
+
+		networkInterfaceInfo, err := getNetworkInterfaceInfo(ctx, server.ID, networkInterface.networkType)
+		if err != nil {
+			return err
+		}
+		body.SetAttributeValue(networkInterface.attribute, cty.BoolVal(networkInterfaceInfo.IsOpened()))
+	}
+
+	return nil
+}
+
+func appendImportBlock(body *hclwrite.Body, resourceType string, name string, id string) {
+	importBody := body.AppendNewBlock("import", nil).Body()
+	importBody.SetAttributeTraversal("to", hcl.Traversal{
+		hcl.TraverseRoot{Name: resourceType},
+		hcl.TraverseAttr{Name: name},
+	})
+	importBody.SetAttributeValue("id", cty.StringVal(id))
+	body.AppendNewline()
+}
+
+func resourceName(parts ...string) string {
+	name := invalidResourceNameChars.ReplaceAllString(strings.ToLower(strings.Join(parts, "_")), "_")
+	return strings.Trim(name, "_")
+}
+
+func envOrDefault(key string, defaultValue string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return defaultValue
+}
@@ -0,0 +1,110 @@
+package leaseweb
+
+import (
+	"fmt"
+	"net/netip"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// normalizeIP validates an IP address, with or without prefix length, and returns
+// its canonical representation without prefix length (e.g. lowercase and compressed for IPv6).
+func normalizeIP(ip string) (string, error) {
+	address := strings.SplitN(ip, "/", 2)[0]
+
+	addr, err := netip.ParseAddr(address)
+	if err != nil {
+		return "", fmt.Errorf("invalid IP address %q: %w", ip, err)
+	}
+
+	if addr.Zone() != "" {
+		return "", fmt.Errorf("invalid IP address %q: zones are not supported", ip)
+	}
+
+	return addr.Unmap().String(), nil
+}
+
+// splitIPPrefix returns the canonical IP address and the prefix length of an IP as returned by the API.
+// The IP is returned untouched with a zero prefix length when it cannot be parsed.
+func splitIPPrefix(ip string) (string, int) {
+	if !strings.Contains(ip, "/") {
+		if address, err := normalizeIP(ip); err == nil {
+			return address, 0
+		}
+		return ip, 0
+	}
+
+	prefix, err := netip.ParsePrefix(ip)
+	if err != nil {
+		return ip, 0
+	}
+
+	return prefix.Addr().Unmap().String(), prefix.Bits()
+}
+
+// ipInPrefix returns whether an IP address belongs to the subnet of an IP as returned by the API.
+func ipInPrefix(ip string, subnet string) bool {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return false
+	}
+
+	prefix, err := netip.ParsePrefix(subnet)
+	if err != nil {
+		return false
+	}
+
+	return prefix.Contains(addr)
+}
+
+func validateIPAddress(i interface{}, k string) ([]string, []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+
+	if strings.Contains(v, "/") {
+		return nil, []error{fmt.Errorf("expected %s to be an IP address without prefix length, got %s", k, v)}
+	}
+
+	if _, err := normalizeIP(v); err != nil {
+		return nil, []error{fmt.Errorf("expected %s to contain a valid IP address: %s", k, err)}
+	}
+
+	return nil, nil
+}
+
+// validatePrivateIPAddress validates an IPv4 address without prefix length belonging to a private range (RFC 1918).
+func validatePrivateIPAddress(i interface{}, k string) ([]string, []error) {
+	warnings, errors := validateIPAddress(i, k)
+	if len(errors) != 0 {
+		return warnings, errors
+	}
+
+	addr, err := netip.ParseAddr(i.(string))
+	if err != nil {
+		return warnings, []error{fmt.Errorf("expected %s to contain a valid IP address: %s", k, err)}
+	}
+
+	addr = addr.Unmap()
+	if !addr.Is4() || !addr.IsPrivate() {
+		return warnings, []error{fmt.Errorf("expected %s to be a private IPv4 address, got %s", k, i)}
+	}
+
+	return warnings, nil
+}
+
+func suppressEquivalentIPDiff(k, old, new string, d *schema.ResourceData) bool {
+	normalizedOld, err := normalizeIP(old)
+	if err != nil {
+		return false
+	}
+
+	normalizedNew, err := normalizeIP(new)
+	if err != nil {
+		return false
+	}
+
+	return normalizedOld == normalizedNew
+}
@@ -0,0 +1,32 @@
+package leaseweb
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// waitForJob polls a job of a dedicated server until it is finished.
+func waitForJob(ctx context.Context, serverID string, jobUUID string, timeout time.Duration, pollInterval time.Duration) (*Job, error) {
+	jobStateConf := &resource.StateChangeConf{
+		Pending: []string{"ACTIVE"},
+		Target:  []string{"FINISHED"},
+		Refresh: func() (interface{}, string, error) {
+			job, err := getJob(ctx, serverID, jobUUID)
+			if err != nil {
+				return nil, "error", err
+			}
+			return job, job.Status, err
+		},
+		Timeout:      timeout,
+		PollInterval: pollInterval,
+	}
+
+	job, err := jobStateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return job.(*Job), nil
+}
@@ -0,0 +1,135 @@
+package leaseweb
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// metricsSchema returns the attributes shared by the metrics data sources.
+func metricsSchema(aggregations []string) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"dedicated_server_id": {
+			Description: "The ID of the dedicated server.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"from": {
+			Description:  "The start of the period, in RFC3339 format.",
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.IsRFC3339Time,
+		},
+		"to": {
+			Description:  "The end of the period, in RFC3339 format.",
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.IsRFC3339Time,
+		},
+		"granularity": {
+			Description: `
+The interval of the values of the series.
+Can be either ` + "`5MIN`" + `, ` + "`HOUR`" + `, ` + "`DAY`" + `, ` + "`WEEK`" + `, ` + "`MONTH`" + ` or ` + "`YEAR`" + `.
+When not set, a single value is returned for the whole period.
+`,
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice([]string{"5MIN", "HOUR", "DAY", "WEEK", "MONTH", "YEAR"}, false),
+		},
+		"aggregation": {
+			Description:  "The aggregation of the values, can be " + strings.Join(quoteAll(aggregations), " or ") + ".",
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      aggregations[0],
+			ValidateFunc: validation.StringInSlice(aggregations, false),
+		},
+		"unit": {
+			Description: "The unit of the values.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"up":   metricSeriesSchema("outgoing"),
+		"down": metricSeriesSchema("incoming"),
+	}
+}
+
+func metricSeriesSchema(direction string) *schema.Schema {
+	return &schema.Schema{
+		Description: "The series of the " + direction + " public traffic.",
+		Type:        schema.TypeList,
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"timestamp": {
+					Description: "The start of the interval of the value.",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+				"value": {
+					Description: "The value, in `unit`.",
+					Type:        schema.TypeFloat,
+					Computed:    true,
+				},
+			},
+		},
+	}
+}
+
+// readServerMetrics gets the metrics matching the data source arguments and sets the shared attributes.
+func readServerMetrics(ctx context.Context, d *schema.ResourceData, metricsType string) (*Metrics, error) {
+	serverID := d.Get("dedicated_server_id").(string)
+
+	filters := MetricsFilters{
+		From:        d.Get("from").(string),
+		To:          d.Get("to").(string),
+		Granularity: d.Get("granularity").(string),
+		Aggregation: d.Get("aggregation").(string),
+	}
+
+	metrics, err := getServerMetrics(ctx, serverID, metricsType, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	unit := metrics.Metrics.UpPublic.Unit
+	if unit == "" {
+		unit = metrics.Metrics.DownPublic.Unit
+	}
+
+	if err := d.Set("unit", unit); err != nil {
+		return nil, err
+	}
+
+	if err := d.Set("up", flattenMetricSeries(metrics.Metrics.UpPublic)); err != nil {
+		return nil, err
+	}
+
+	if err := d.Set("down", flattenMetricSeries(metrics.Metrics.DownPublic)); err != nil {
+		return nil, err
+	}
+
+	d.SetId(strings.Join([]string{serverID, metricsType, filters.From, filters.To, filters.Granularity, filters.Aggregation}, ":"))
+
+	return metrics, nil
+}
+
+func flattenMetricSeries(series MetricSeries) []map[string]interface{} {
+	values := make([]map[string]interface{}, len(series.Values))
+	for i, value := range series.Values {
+		values[i] = map[string]interface{}{
+			"timestamp": value.Timestamp,
+			"value":     value.Value,
+		}
+	}
+	return values
+}
+
+func quoteAll(values []string) []string {
+	quoted := make([]string, len(values))
+	for i, value := range values {
+		quoted[i] = "`" + value + "`"
+	}
+	return quoted
+}
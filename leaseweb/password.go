@@ -0,0 +1,97 @@
+package leaseweb
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+)
+
+const (
+	passwordLowerCharacters   = "abcdefghijklmnopqrstuvwxyz"
+	passwordUpperCharacters   = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	passwordNumericCharacters = "0123456789"
+)
+
+// PasswordRules -
+type PasswordRules struct {
+	Length            int
+	MaxLength         int
+	Special           bool
+	SpecialCharacters string
+}
+
+// defaultPasswordRules returns the rules used to generate a password for a credential type.
+// Remote management controllers (IPMI) commonly truncate passwords after 20 characters
+// and choke on shell or URL sensitive characters, so they get a more conservative default.
+func defaultPasswordRules(credentialType string) PasswordRules {
+	if credentialType == "REMOTE_MANAGEMENT" {
+		return PasswordRules{
+			Length:            16,
+			MaxLength:         20,
+			Special:           true,
+			SpecialCharacters: "!#%+-=_",
+		}
+	}
+
+	return PasswordRules{
+		Length:            24,
+		MaxLength:         128,
+		Special:           true,
+		SpecialCharacters: "!#$%&*()-_=+[]{}<>:?",
+	}
+}
+
+// generatePassword returns a random password containing at least one character of each enabled class.
+func generatePassword(rules PasswordRules) (string, error) {
+	classes := []string{passwordLowerCharacters, passwordUpperCharacters, passwordNumericCharacters}
+	if rules.Special && rules.SpecialCharacters != "" {
+		classes = append(classes, rules.SpecialCharacters)
+	}
+
+	if rules.Length < len(classes) {
+		return "", fmt.Errorf("password length must be at least %d", len(classes))
+	}
+
+	if rules.MaxLength != 0 && rules.Length > rules.MaxLength {
+		return "", fmt.Errorf("password length must be at most %d", rules.MaxLength)
+	}
+
+	var all string
+	password := make([]byte, 0, rules.Length)
+
+	for _, class := range classes {
+		c, err := randomCharacter(class)
+		if err != nil {
+			return "", err
+		}
+		password = append(password, c)
+		all += class
+	}
+
+	for len(password) < rules.Length {
+		c, err := randomCharacter(all)
+		if err != nil {
+			return "", err
+		}
+		password = append(password, c)
+	}
+
+	// shuffle so the guaranteed characters are not always at the start
+	for i := len(password) - 1; i > 0; i-- {
+		j, err := rand.Int(rand.Reader, big.NewInt(int64(i+1)))
+		if err != nil {
+			return "", err
+		}
+		password[i], password[j.Int64()] = password[j.Int64()], password[i]
+	}
+
+	return string(password), nil
+}
+
+func randomCharacter(characters string) (byte, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(int64(len(characters))))
+	if err != nil {
+		return 0, err
+	}
+	return characters[n.Int64()], nil
+}
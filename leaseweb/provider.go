@@ -38,12 +38,31 @@ By default it takes the value from the ` + "`LEASEWEB_API_TOKEN`" + ` environmen
 			"leaseweb_dedicated_server_installation":                     resourceDedicatedServerInstallation(),
 			"leaseweb_dedicated_server_notification_setting_bandwidth":   resourceDedicatedServerNotificationSettingBandwidth(),
 			"leaseweb_dedicated_server_notification_setting_datatraffic": resourceDedicatedServerNotificationSettingDatatraffic(),
+			"leaseweb_dedicated_server_notification_setting_ddos":        resourceDedicatedServerNotificationSettingDDoS(),
 			"leaseweb_dedicated_server_credential":                       resourceDedicatedServerCredential(),
+			"leaseweb_dedicated_server_ip":                               resourceDedicatedServerIP(),
+			"leaseweb_dedicated_server_hardware_scan":                    resourceDedicatedServerHardwareScan(),
+			"leaseweb_dedicated_server_ipmi_reset":                       resourceDedicatedServerIPMIReset(),
+			"leaseweb_dedicated_server_power_cycle":                      resourceDedicatedServerPowerCycle(),
+			"leaseweb_dedicated_server_rescue_mode":                      resourceDedicatedServerRescueMode(),
+			"leaseweb_private_network_dhcp_reservation":                  resourcePrivateNetworkDHCPReservation(),
+			"leaseweb_dedicated_server_private_network":                  resourceDedicatedServerPrivateNetwork(),
 		},
 		DataSourcesMap: map[string]*schema.Resource{
-			"leaseweb_dedicated_server_operating_systems": dataSourceDedicatedServerOperatingSystems(),
-			"leaseweb_dedicated_server_control_panels":    dataSourceDedicatedServerControlPanels(),
-			"leaseweb_dedicated_servers":                  dataSourceDedicatedServers(),
+			"leaseweb_dedicated_server_operating_systems":     dataSourceDedicatedServerOperatingSystems(),
+			"leaseweb_dedicated_server_control_panels":        dataSourceDedicatedServerControlPanels(),
+			"leaseweb_dedicated_server":                       dataSourceDedicatedServer(),
+			"leaseweb_dedicated_servers":                      dataSourceDedicatedServers(),
+			"leaseweb_dedicated_server_credentials":           dataSourceDedicatedServerCredentials(),
+			"leaseweb_dedicated_server_null_route_history":    dataSourceDedicatedServerNullRouteHistory(),
+			"leaseweb_dedicated_server_notification_settings": dataSourceDedicatedServerNotificationSettings(),
+			"leaseweb_dedicated_server_ips":                   dataSourceDedicatedServerIPs(),
+			"leaseweb_dedicated_server_hardware":              dataSourceDedicatedServerHardware(),
+			"leaseweb_dedicated_server_metrics_bandwidth":     dataSourceDedicatedServerMetricsBandwidth(),
+			"leaseweb_dedicated_server_metrics_datatraffic":   dataSourceDedicatedServerMetricsDatatraffic(),
+			"leaseweb_private_network_dhcp_reservations":      dataSourcePrivateNetworkDHCPReservations(),
+			"leaseweb_private_networks":                       dataSourcePrivateNetworks(),
+			"leaseweb_dedicated_server_rescue_images":         dataSourceDedicatedServerRescueImages(),
 		},
 		ConfigureContextFunc: providerConfigure,
 	}
@@ -59,9 +78,13 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}
 
 	var diags diag.Diagnostics
 
+	configureAPIClient(baseURL, apiToken)
+
+	return nil, diags
+}
+
+func configureAPIClient(baseURL string, apiToken string) {
 	leasewebAPIURL = baseURL
 	leasewebAPIToken = apiToken
 	leasewebClient = &http.Client{Timeout: 60 * time.Second}
-
-	return nil, diags
 }
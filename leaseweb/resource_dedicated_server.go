@@ -2,9 +2,11 @@ package leaseweb
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -19,6 +21,7 @@ The resource cannot currently be created automatically, it needs to be imported
 		ReadContext:   resourceDedicatedServerRead,
 		UpdateContext: resourceDedicatedServerUpdate,
 		DeleteContext: resourceDedicatedServerDelete,
+		SchemaVersion: 0,
 		Schema: map[string]*schema.Schema{
 			"id": {
 				Description: "The ID of the dedicated server.",
@@ -37,6 +40,11 @@ The resource cannot currently be created automatically, it needs to be imported
 				Optional:    true,
 				Computed:    true,
 			},
+			"reverse_lookup_ip": {
+				Description: "The public IP the reverse lookup belongs to, as of the last refresh.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
 			"dhcp_lease": {
 				Description:  "The URL of PXE boot the dedicated server is booting from.",
 				Type:         schema.TypeString,
@@ -56,6 +64,18 @@ The resource cannot currently be created automatically, it needs to be imported
 				Optional:    true,
 				Computed:    true,
 			},
+			"private_network_interface_opened": {
+				Description: "Whether the internal network interface of the dedicated server, used by private networks, is opened or not. Null when the dedicated server has no internal network interface.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+			"remote_management_interface_opened": {
+				Description: "Whether the remote management network interface of the dedicated server is opened or not. Null when the dedicated server has no remote management network interface.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
 			"public_ip_null_routed": {
 				Description: "Whether the public IP of the dedicated server is null routed or not.",
 				Type:        schema.TypeBool,
@@ -78,15 +98,35 @@ Available fields are ` + "`rack`" + `, ` + "`site`" + `, ` + "`suite`" + ` and `
 				Type:        schema.TypeString,
 				Computed:    true,
 			},
+			"public_ip_prefix_length": {
+				Description: "The prefix length of the public IP of the dedicated server.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"public_ipv6": {
+				Description: "The first address of the public IPv6 subnet of the dedicated server, if any.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"public_ipv6_prefix_length": {
+				Description: "The prefix length of the public IPv6 subnet of the dedicated server, if any.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
 			"remote_management_ip": {
 				Description: "The remote management IP of the dedicated server.",
 				Type:        schema.TypeString,
 				Computed:    true,
 			},
+			"contract": dedicatedServerContractSchema(),
+			"specs":    dedicatedServerSpecsSchema(),
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 	}
 }
 
@@ -105,42 +145,106 @@ func resourceDedicatedServerRead(ctx context.Context, d *schema.ResourceData, m
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	d.Set("reference", server.Contract.Reference)
-	d.Set("public_ip", server.NetworkInterfaces.Public.IP)
-	d.Set("remote_management_ip", server.NetworkInterfaces.RemoteManagement.IP)
 
-	d.Set("location", map[string]string{
-		"rack":  server.Location.Rack,
-		"site":  server.Location.Site,
-		"suite": server.Location.Suite,
-		"unit":  server.Location.Unit,
-	})
+	if err := setDedicatedServerAttributes(d, map[string]interface{}{
+		"reference":               server.Contract.Reference,
+		"public_ip":               server.NetworkInterfaces.Public.IP,
+		"public_ip_prefix_length": server.NetworkInterfaces.Public.PrefixLength,
+		"remote_management_ip":    server.NetworkInterfaces.RemoteManagement.IP,
+		"location": map[string]string{
+			"rack":  server.Location.Rack,
+			"site":  server.Location.Site,
+			"suite": server.Location.Suite,
+			"unit":  server.Location.Unit,
+		},
+		"contract": flattenServerContract(server),
+		"specs":    flattenServerSpecs(server),
+	}); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// the data below comes from other endpoints, failing to read it should not prevent refreshing the rest,
+	// the previous values are kept in the state and a warning is emitted instead
+
+	// get IP data, the reverse lookup always belongs to the current public IP
+	publicIP := server.NetworkInterfaces.Public.IP
+	if previousPublicIP := d.Get("reverse_lookup_ip").(string); previousPublicIP != "" && previousPublicIP != publicIP {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Public IP of the dedicated server changed",
+			Detail:   fmt.Sprintf("The public IP of dedicated server %s changed from %s to %s, reverse_lookup and public_ip_null_routed now apply to %s.", serverID, previousPublicIP, publicIP, publicIP),
+		})
+	}
+
+	if publicIP != "" {
+		ip, err := getServerIP(ctx, serverID, publicIP)
+		if err != nil {
+			diags = append(diags, readWarning("public IP", serverID, err))
+		} else if err := setDedicatedServerAttributes(d, map[string]interface{}{
+			"reverse_lookup":        ip.ReverseLookup,
+			"reverse_lookup_ip":     publicIP,
+			"public_ip_null_routed": ip.NullRouted,
+		}); err != nil {
+			return diag.FromErr(err)
+		}
+	}
 
-	// get IP data
-	ip, err := getServerIP(ctx, serverID, server.NetworkInterfaces.Public.IP)
+	// get IPv6 data
+	ipv6s, err := getAllServerIPs(ctx, serverID, ServerIPFilters{NetworkType: "PUBLIC", Version: "6"})
 	if err != nil {
-		return diag.FromErr(err)
+		diags = append(diags, readWarning("public IPv6 subnet", serverID, err))
+	} else {
+		publicIPv6 := ""
+		publicIPv6PrefixLength := 0
+		if len(ipv6s) != 0 {
+			publicIPv6 = ipv6s[0].GetAddress()
+			publicIPv6PrefixLength = ipv6s[0].GetPrefixLength()
+		}
+
+		if err := setDedicatedServerAttributes(d, map[string]interface{}{
+			"public_ipv6":               publicIPv6,
+			"public_ipv6_prefix_length": publicIPv6PrefixLength,
+		}); err != nil {
+			return diag.FromErr(err)
+		}
 	}
-	d.Set("reverse_lookup", ip.ReverseLookup)
-	d.Set("public_ip_null_routed", ip.NullRouted)
 
 	// get lease data
 	lease, err := getServerLease(ctx, serverID)
 	if err != nil {
+		diags = append(diags, readWarning("DHCP lease", serverID, err))
+	} else if err := d.Set("dhcp_lease", lease.GetBootfile()); err != nil {
 		return diag.FromErr(err)
 	}
-	d.Set("dhcp_lease", lease.GetBootfile())
 
 	// get power data
 	powerInfo, err := getPowerInfo(ctx, serverID)
 	if err != nil {
+		diags = append(diags, readWarning("power state", serverID, err))
+	} else if err := d.Set("powered_on", powerInfo.IsPoweredOn()); err != nil {
 		return diag.FromErr(err)
 	}
-	d.Set("powered_on", powerInfo.IsPoweredOn())
 
-	// get public network interface data
-	publicNetworkInterfaceInfo, err := getNetworkInterfaceInfo(ctx, serverID, "public")
-	d.Set("public_network_interface_opened", publicNetworkInterfaceInfo.IsOpened())
+	// get network interfaces data
+	for _, networkInterface := range dedicatedServerNetworkInterfaces {
+		if !networkInterface.exists(server) {
+			// the server has no such interface, there is no state to report
+			if err := d.Set(networkInterface.attribute, nil); err != nil {
+				return diag.FromErr(err)
+			}
+			continue
+		}
+
+		networkInterfaceInfo, err := getNetworkInterfaceInfo(ctx, serverID, networkInterface.networkType)
+		if err != nil {
+			diags = append(diags, readWarning(networkInterface.networkType+" network interface", serverID, err))
+			continue
+		}
+
+		if err := d.Set(networkInterface.attribute, networkInterfaceInfo.IsOpened()); err != nil {
+			return diag.FromErr(err)
+		}
+	}
 
 	return diags
 }
@@ -158,8 +262,17 @@ func resourceDedicatedServerUpdate(ctx context.Context, d *schema.ResourceData,
 		time.Sleep(5 * time.Second)
 	}
 
+	// the public IP may have changed since the last refresh, always act on the current one
+	var publicIP string
+	if d.HasChanges("reverse_lookup", "public_ip_null_routed") {
+		server, err := getServer(ctx, serverID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		publicIP = server.NetworkInterfaces.Public.IP
+	}
+
 	if d.HasChange("reverse_lookup") {
-		publicIP := d.Get("public_ip").(string)
 		reverseLookup := d.Get("reverse_lookup").(string)
 		if err := updateReverseLookup(ctx, serverID, publicIP, reverseLookup); err != nil {
 			return diag.FromErr(err)
@@ -180,7 +293,8 @@ func resourceDedicatedServerUpdate(ctx context.Context, d *schema.ResourceData,
 	}
 
 	if d.HasChange("powered_on") {
-		if d.Get("powered_on").(bool) {
+		poweredOn := d.Get("powered_on").(bool)
+		if poweredOn {
 			if err := powerOnServer(ctx, serverID); err != nil {
 				return diag.FromErr(err)
 			}
@@ -189,22 +303,18 @@ func resourceDedicatedServerUpdate(ctx context.Context, d *schema.ResourceData,
 				return diag.FromErr(err)
 			}
 		}
-	}
 
-	if d.HasChange("public_network_interface_opened") {
-		if d.Get("public_network_interface_opened").(bool) {
-			if err := openNetworkInterface(ctx, serverID, "public"); err != nil {
-				return diag.FromErr(err)
-			}
-		} else {
-			if err := closeNetworkInterface(ctx, serverID, "public"); err != nil {
-				return diag.FromErr(err)
-			}
+		// the power operations are asynchronous, wait for them to be done before reading the resource again
+		if err := waitForPowerState(ctx, serverID, poweredOn, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.FromErr(err)
 		}
 	}
 
+	if err := updateNetworkInterfaces(ctx, d, serverID); err != nil {
+		return diag.FromErr(err)
+	}
+
 	if d.HasChange("public_ip_null_routed") {
-		publicIP := d.Get("public_ip").(string)
 		if d.Get("public_ip_null_routed").(bool) {
 			if err := nullIP(ctx, serverID, publicIP); err != nil {
 				return diag.FromErr(err)
@@ -227,3 +337,153 @@ func resourceDedicatedServerDelete(ctx context.Context, d *schema.ResourceData,
 
 	return diags
 }
+
+// setDedicatedServerAttributes sets several attributes, stopping at the first error.
+func setDedicatedServerAttributes(d *schema.ResourceData, attributes map[string]interface{}) error {
+	for k, v := range attributes {
+		if err := d.Set(k, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readWarning returns the warning emitted when a part of a dedicated server cannot be read.
+func readWarning(part string, serverID string, err error) diag.Diagnostic {
+	return diag.Diagnostic{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("Unable to read the %s of the dedicated server", part),
+		Detail:   fmt.Sprintf("The %s of dedicated server %s could not be read, its previous state is kept: %s", part, serverID, err),
+	}
+}
+
+// dedicatedServerNetworkInterfaces lists the attributes holding the state of the network interfaces
+// of a dedicated server, with the network type used by the API for them.
+var dedicatedServerNetworkInterfaces = []struct {
+	attribute   string
+	networkType string
+	exists      func(server *Server) bool
+}{
+	{
+		attribute:   "public_network_interface_opened",
+		networkType: "public",
+		exists:      func(server *Server) bool { return true },
+	},
+	{
+		attribute:   "private_network_interface_opened",
+		networkType: "internal",
+		exists: func(server *Server) bool {
+			return server.NetworkInterfaces.Internal.MAC != "" || server.NetworkInterfaces.Internal.IP != ""
+		},
+	},
+	{
+		attribute:   "remote_management_interface_opened",
+		networkType: "remoteManagement",
+		exists: func(server *Server) bool {
+			return server.NetworkInterfaces.RemoteManagement.MAC != "" || server.NetworkInterfaces.RemoteManagement.IP != ""
+		},
+	},
+}
+
+// updateNetworkInterfaces opens or closes the network interfaces whose state changed,
+// all at once when they all change to the same state.
+func updateNetworkInterfaces(ctx context.Context, d *schema.ResourceData, serverID string) error {
+	var server *Server
+	allChanged := true
+	allSame := true
+	opened := d.Get(dedicatedServerNetworkInterfaces[0].attribute).(bool)
+
+	for _, networkInterface := range dedicatedServerNetworkInterfaces {
+		if d.Get(networkInterface.attribute).(bool) != opened {
+			allSame = false
+		}
+
+		if !d.HasChange(networkInterface.attribute) {
+			allChanged = false
+			continue
+		}
+
+		// interfaces the server does not have are read as null, they cannot be opened or closed
+
+		if server == nil {
+			var err error
+			if server, err = getServer(ctx, serverID); err != nil {
+				return err
+			}
+		}
+
+		if !networkInterface.exists(server) {
+			return fmt.Errorf("dedicated server %s has no %s network interface, %s cannot be set", serverID, networkInterface.networkType, networkInterface.attribute)
+		}
+	}
+
+	if allChanged && allSame {
+		if opened {
+			return openAllNetworkInterfaces(ctx, serverID)
+		}
+		return closeAllNetworkInterfaces(ctx, serverID)
+	}
+
+	for _, networkInterface := range dedicatedServerNetworkInterfaces {
+		if !d.HasChange(networkInterface.attribute) {
+			continue
+		}
+
+		if d.Get(networkInterface.attribute).(bool) {
+			if err := openNetworkInterface(ctx, serverID, networkInterface.networkType); err != nil {
+				return err
+			}
+		} else {
+			if err := closeNetworkInterface(ctx, serverID, networkInterface.networkType); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func waitForPowerState(ctx context.Context, serverID string, poweredOn bool, timeout time.Duration) error {
+	target := "off"
+	if poweredOn {
+		target = "on"
+	}
+
+	powerStateConf := &resource.StateChangeConf{
+		Pending: []string{"pending"},
+		Target:  []string{target},
+		Refresh: func() (interface{}, string, error) {
+			powerInfo, err := getPowerInfo(ctx, serverID)
+			if err != nil {
+				return nil, "error", err
+			}
+			if !powerInfo.HasStatus(target) {
+				return powerInfo, "pending", nil
+			}
+			return powerInfo, target, nil
+		},
+		Timeout:      timeout,
+		Delay:        5 * time.Second,
+		PollInterval: 10 * time.Second,
+	}
+	_, err := powerStateConf.WaitForStateContext(ctx)
+
+	return err
+}
+
+// powerCycleGracePeriod is the time given to a dedicated server to go through a power cycle.
+const powerCycleGracePeriod = 30 * time.Second
+
+// waitForPowerCycle waits for a dedicated server to be powered on again after a power cycle.
+// The server still reports being powered on right after the power cycle is requested and is only
+// off for a few seconds, which polling cannot reliably observe (the PDU even keeps reporting "on"
+// during an IPMI power cycle), so the power state is only checked after a grace period.
+func waitForPowerCycle(ctx context.Context, serverID string, timeout time.Duration) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(powerCycleGracePeriod):
+	}
+
+	return waitForPowerState(ctx, serverID, true, timeout-powerCycleGracePeriod)
+}
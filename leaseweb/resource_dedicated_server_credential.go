@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -20,6 +21,14 @@ linked to a dedicated server.
 		ReadContext:   resourceDedicatedServerCredentialRead,
 		UpdateContext: resourceDedicatedServerCredentialUpdate,
 		DeleteContext: resourceDedicatedServerCredentialDelete,
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Version: 0,
+				Type:    resourceDedicatedServerCredentialV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: resourceDedicatedServerCredentialStateUpgradeV0,
+			},
+		},
 		Schema: map[string]*schema.Schema{
 			"dedicated_server_id": {
 				Description: "The ID of the dedicated server.",
@@ -44,11 +53,46 @@ Can be either ` + "`OPERATING_SYSTEM`" + `, ` + "`CONTROL_PANEL`" + `, ` + "`REM
 				ForceNew:    true,
 			},
 			"password": {
-				Description: "The password of the credential.",
+				Description: "The password of the credential. A random password is generated when it is not set.",
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"password_length": {
+				Description: `
+The length of the generated password.
+Defaults to 16 for ` + "`REMOTE_MANAGEMENT`" + ` credentials (at most 20 are allowed) and 24 for the other types.
+Changing it generates a new password when ` + "`password`" + ` is not set.
+`,
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(4),
+			},
+			"password_special": {
+				Description: "Whether the generated password contains special characters. Changing it generates a new password when `password` is not set.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			"password_override_special": {
+				Description: "The special characters to use in the generated password instead of the defaults of the credential type. Changing it generates a new password when `password` is not set.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"keepers": {
+				Description: `
+Arbitrary map of values that, when changed, will generate a new password and update the credential in place.
+Only used when ` + "`password`" + ` is not set.
+`,
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
 			},
 		},
+		CustomizeDiff: resourceDedicatedServerCredentialCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 				parts := strings.SplitN(d.Id(), ":", 3)
@@ -60,7 +104,9 @@ Can be either ` + "`OPERATING_SYSTEM`" + `, ` + "`CONTROL_PANEL`" + `, ` + "`REM
 				d.Set("dedicated_server_id", parts[0])
 				d.Set("type", parts[1])
 				d.Set("username", parts[2])
-				d.SetId(parts[0] + parts[1] + parts[2])
+				// the password is read as is, the generation rules only apply to the next generated password
+				d.Set("password_special", true)
+				d.SetId(parts[0] + ":" + parts[1] + ":" + parts[2])
 
 				return []*schema.ResourceData{d}, nil
 			},
@@ -77,12 +123,20 @@ func resourceDedicatedServerCredentialCreate(ctx context.Context, d *schema.Reso
 		Password: d.Get("password").(string),
 	}
 
+	if !isCredentialPasswordConfigured(d) {
+		password, err := generatePassword(credentialPasswordRules(d))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		credential.Password = password
+	}
+
 	createdCredential, err := createDedicatedServerCredential(ctx, serverID, &credential)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	d.SetId(serverID + createdCredential.Type + createdCredential.Username)
+	d.SetId(serverID + ":" + createdCredential.Type + ":" + createdCredential.Username)
 
 	return resourceDedicatedServerCredentialRead(ctx, d, m)
 }
@@ -113,6 +167,20 @@ func resourceDedicatedServerCredentialUpdate(ctx context.Context, d *schema.Reso
 		Password: d.Get("password").(string),
 	}
 
+	if !isCredentialPasswordConfigured(d) {
+		if !credentialPasswordGenerationChanged(d) {
+			return resourceDedicatedServerCredentialRead(ctx, d, m)
+		}
+
+		password, err := generatePassword(credentialPasswordRules(d))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		credential.Password = password
+	} else if !d.HasChange("password") {
+		return resourceDedicatedServerCredentialRead(ctx, d, m)
+	}
+
 	if _, err := updateDedicatedServerCredential(ctx, serverID, &credential); err != nil {
 		return diag.FromErr(err)
 	}
@@ -137,3 +205,116 @@ func resourceDedicatedServerCredentialDelete(ctx context.Context, d *schema.Reso
 
 	return diags
 }
+
+func resourceDedicatedServerCredentialCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.GetRawConfig().GetAttr("password").IsNull() {
+		return nil
+	}
+
+	rules := defaultPasswordRules(d.Get("type").(string))
+	if length := d.Get("password_length").(int); length != 0 && rules.MaxLength != 0 && length > rules.MaxLength {
+		return fmt.Errorf("password_length must be at most %d for %s credentials", rules.MaxLength, d.Get("type").(string))
+	}
+
+	// a new password will be generated during the update
+	if d.Id() != "" && credentialPasswordGenerationChanged(d) {
+		return d.SetNewComputed("password")
+	}
+
+	return nil
+}
+
+// credentialPasswordGenerationAttributes lists the attributes which generate a new password when they change.
+var credentialPasswordGenerationAttributes = []string{"keepers", "password_length", "password_special", "password_override_special"}
+
+// credentialPasswordGenerationChanged returns whether a new password has to be generated. Besides the keepers,
+// only the generation rules which were already set in the state count: a rule missing from the state (e.g. after
+// an import) did not generate the current password, setting it must not replace that password.
+func credentialPasswordGenerationChanged(d interface {
+	HasChange(string) bool
+	GetRawState() cty.Value
+}) bool {
+	rawState := d.GetRawState()
+
+	for _, attribute := range credentialPasswordGenerationAttributes {
+		if !d.HasChange(attribute) {
+			continue
+		}
+		if attribute == "keepers" || rawState.IsNull() || !rawState.GetAttr(attribute).IsNull() {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isCredentialPasswordConfigured(d *schema.ResourceData) bool {
+	return !d.GetRawConfig().GetAttr("password").IsNull()
+}
+
+func credentialPasswordRules(d *schema.ResourceData) PasswordRules {
+	rules := defaultPasswordRules(d.Get("type").(string))
+
+	if length := d.Get("password_length").(int); length != 0 {
+		rules.Length = length
+	}
+
+	rules.Special = d.Get("password_special").(bool)
+
+	if overrideSpecial := d.Get("password_override_special").(string); overrideSpecial != "" {
+		rules.SpecialCharacters = overrideSpecial
+	}
+
+	return rules
+}
+
+// resourceDedicatedServerCredentialV0 is the schema of the resource before the ID
+// contained separators between the server ID, the credential type and the username.
+func resourceDedicatedServerCredentialV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"dedicated_server_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"username": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"password": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceDedicatedServerCredentialStateUpgradeV0(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	if rawState == nil {
+		return rawState, nil
+	}
+
+	serverID, _ := rawState["dedicated_server_id"].(string)
+	credentialType, _ := rawState["type"].(string)
+	username, _ := rawState["username"].(string)
+
+	if serverID == "" || credentialType == "" || username == "" {
+		return nil, fmt.Errorf("unable to upgrade the state of credential %v, missing dedicated_server_id, type or username", rawState["id"])
+	}
+
+	rawState["id"] = serverID + ":" + credentialType + ":" + username
+
+	// the password generation attributes did not exist yet, use their defaults so the upgrade does not cause a diff
+	if _, ok := rawState["password_special"]; !ok {
+		rawState["password_special"] = true
+	}
+
+	return rawState, nil
+}
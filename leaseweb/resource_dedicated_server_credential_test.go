@@ -0,0 +1,118 @@
+package leaseweb
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestResourceDedicatedServerCredentialImportPlan(t *testing.T) {
+	r := resourceDedicatedServerCredential()
+
+	imported, err := r.Importer.State(r.Data(&terraform.InstanceState{ID: "12345:OPERATING_SYSTEM:root"}), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the password is read right after the import
+	state := imported[0].State()
+	state.Attributes["password"] = "current-password"
+
+	diff := planDedicatedServerCredential(t, r, state, map[string]cty.Value{
+		"dedicated_server_id": cty.StringVal("12345"),
+		"type":                cty.StringVal("OPERATING_SYSTEM"),
+		"username":            cty.StringVal("root"),
+	})
+
+	if diff != nil && !diff.Empty() {
+		t.Fatalf("expected no diff after import, got %#v", diff.Attributes)
+	}
+}
+
+func TestResourceDedicatedServerCredentialNewGenerationRulePlan(t *testing.T) {
+	r := resourceDedicatedServerCredential()
+
+	// state of a credential imported before password_special was set on import
+	state := &terraform.InstanceState{
+		ID: "12345:OPERATING_SYSTEM:root",
+		Attributes: map[string]string{
+			"id":                  "12345:OPERATING_SYSTEM:root",
+			"dedicated_server_id": "12345",
+			"type":                "OPERATING_SYSTEM",
+			"username":            "root",
+			"password":            "current-password",
+		},
+	}
+
+	diff := planDedicatedServerCredential(t, r, state, map[string]cty.Value{
+		"dedicated_server_id": cty.StringVal("12345"),
+		"type":                cty.StringVal("OPERATING_SYSTEM"),
+		"username":            cty.StringVal("root"),
+		"password_length":     cty.NumberIntVal(32),
+	})
+
+	if diff != nil {
+		if attr, ok := diff.Attributes["password"]; ok && attr.NewComputed {
+			t.Fatal("expected the current password to be kept when a generation rule is set for the first time")
+		}
+	}
+}
+
+func TestResourceDedicatedServerCredentialChangedGenerationRulePlan(t *testing.T) {
+	r := resourceDedicatedServerCredential()
+
+	state := &terraform.InstanceState{
+		ID: "12345:OPERATING_SYSTEM:root",
+		Attributes: map[string]string{
+			"id":                  "12345:OPERATING_SYSTEM:root",
+			"dedicated_server_id": "12345",
+			"type":                "OPERATING_SYSTEM",
+			"username":            "root",
+			"password":            "current-password",
+			"password_length":     "24",
+			"password_special":    "true",
+		},
+	}
+
+	diff := planDedicatedServerCredential(t, r, state, map[string]cty.Value{
+		"dedicated_server_id": cty.StringVal("12345"),
+		"type":                cty.StringVal("OPERATING_SYSTEM"),
+		"username":            cty.StringVal("root"),
+		"password_length":     cty.NumberIntVal(32),
+	})
+
+	if diff == nil || diff.Attributes["password"] == nil || !diff.Attributes["password"].NewComputed {
+		t.Fatal("expected a new password to be generated when a generation rule changes")
+	}
+}
+
+// planDedicatedServerCredential computes the diff of the given state against the given configuration,
+// with the raw values Terraform sends during a plan.
+func planDedicatedServerCredential(t *testing.T, r *schema.Resource, state *terraform.InstanceState, config map[string]cty.Value) *terraform.InstanceDiff {
+	t.Helper()
+
+	configSchema := r.CoreConfigSchema()
+
+	rawConfig, err := configSchema.CoerceValue(cty.ObjectVal(config))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rawState, err := state.AttrsAsObjectValue(configSchema.ImpliedType())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	state.RawConfig = rawConfig
+	state.RawState = rawState
+
+	diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigShimmed(rawConfig, configSchema), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return diff
+}
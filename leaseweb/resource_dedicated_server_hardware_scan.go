@@ -0,0 +1,129 @@
+package leaseweb
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceDedicatedServerHardwareScan() *schema.Resource {
+	resourceSchema := map[string]*schema.Schema{
+		"dedicated_server_id": {
+			Description: "The ID of the dedicated server.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"power_cycle": {
+			Description: "Whether to power cycle the dedicated server to boot the scan environment. The job fails if the server is not powered on otherwise.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			ForceNew:    true,
+			Default:     true,
+		},
+		"callback_url": {
+			Description:  "The URL which will receive callbacks when the hardware scan is finished or failed.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IsURLWithScheme([]string{"http", "https"}),
+		},
+		"triggers": {
+			Description: "Arbitrary map of values that, when changed, will launch a new hardware scan.",
+			Type:        schema.TypeMap,
+			Optional:    true,
+			ForceNew:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"job_uuid": {
+			Description: "The UUID of the hardware scan job.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+
+	for k, v := range hardwareInfoSchema() {
+		resourceSchema[k] = v
+	}
+
+	return &schema.Resource{
+		Description: `
+The ` + "`dedicated_server_hardware_scan`" + ` resource launches a hardware scan of a dedicated server
+and exposes its results. The results are those of the scan launched by the resource, later scans of the
+dedicated server do not change them. Use the ` + "`dedicated_server_hardware`" + ` data source to get the results of the latest scan.
+`,
+		CreateContext: resourceDedicatedServerHardwareScanCreate,
+		ReadContext:   resourceDedicatedServerHardwareScanRead,
+		DeleteContext: resourceDedicatedServerHardwareScanDelete,
+		SchemaVersion: 0,
+		Schema:        resourceSchema,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+	}
+}
+
+func resourceDedicatedServerHardwareScanCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	serverID := d.Get("dedicated_server_id").(string)
+
+	var payload = Payload{
+		"powerCycle": d.Get("power_cycle").(bool),
+	}
+
+	if d.Get("callback_url") != "" {
+		payload["callbackUrl"] = d.Get("callback_url").(string)
+	}
+
+	hardwareScanJob, err := launchHardwareScanJob(ctx, serverID, &payload)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("job_uuid", hardwareScanJob.UUID)
+	d.SetId(serverID)
+
+	_, err = waitForJob(ctx, serverID, hardwareScanJob.UUID, d.Timeout(schema.TimeoutCreate)-time.Minute, 30*time.Second)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// the hardware info of the server is the result of its latest scan, which is the one we just finished
+	hardwareInfo, err := getHardwareInfo(ctx, serverID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := setHardwareInfo(d, hardwareInfo); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceDedicatedServerHardwareScanRead(ctx, d, m)
+}
+
+func resourceDedicatedServerHardwareScanRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	serverID := d.Get("dedicated_server_id").(string)
+
+	var diags diag.Diagnostics
+
+	// NOTE: the results are not read again, later scans of the server would overwrite them
+	hardwareScanJob, err := getJob(ctx, serverID, d.Get("job_uuid").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("job_uuid", hardwareScanJob.UUID)
+
+	return diags
+}
+
+func resourceDedicatedServerHardwareScanDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	d.SetId("")
+
+	return diags
+}
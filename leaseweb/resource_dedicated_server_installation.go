@@ -7,7 +7,6 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -21,6 +20,7 @@ The resource cannot be updated in place, modifying any data will launch a new in
 		CreateContext: resourceDedicatedServerInstallationCreate,
 		ReadContext:   resourceDedicatedServerInstallationRead,
 		DeleteContext: resourceDedicatedServerInstallationDelete,
+		SchemaVersion: 0,
 		Schema: map[string]*schema.Schema{
 			"dedicated_server_id": {
 				Description: "The ID of the dedicated server.",
@@ -262,21 +262,7 @@ func resourceDedicatedServerInstallationCreate(ctx context.Context, d *schema.Re
 	d.Set("job_uuid", installationJob.UUID)
 	d.SetId(serverID)
 
-	createStateConf := &resource.StateChangeConf{
-		Pending: []string{"ACTIVE"},
-		Target:  []string{"FINISHED"},
-		Refresh: func() (interface{}, string, error) {
-			job, err := getJob(ctx, serverID, installationJob.UUID)
-			if err != nil {
-				return nil, "error", err
-			}
-			return job, job.Status, err
-		},
-		Timeout:      d.Timeout(schema.TimeoutCreate) - time.Minute,
-		PollInterval: 30 * time.Second,
-	}
-	_, err = createStateConf.WaitForStateContext(ctx)
-
+	_, err = waitForJob(ctx, serverID, installationJob.UUID, d.Timeout(schema.TimeoutCreate)-time.Minute, 30*time.Second)
 	if err != nil {
 		return diag.FromErr(err)
 	}
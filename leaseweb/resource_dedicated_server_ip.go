@@ -0,0 +1,219 @@
+package leaseweb
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceDedicatedServerIP() *schema.Resource {
+	return &schema.Resource{
+		Description: `
+The ` + "`dedicated_server_ip`" + ` resource manages an IP assigned to a dedicated server.
+The IP is not released when the resource is destroyed, it is only removed from the state.
+Individual IPv6 addresses of the IPv6 subnet assigned to the dedicated server can be managed as well.
+`,
+		CreateContext: resourceDedicatedServerIPCreate,
+		ReadContext:   resourceDedicatedServerIPRead,
+		UpdateContext: resourceDedicatedServerIPUpdate,
+		DeleteContext: resourceDedicatedServerIPDelete,
+		SchemaVersion: 0,
+		Schema: map[string]*schema.Schema{
+			"dedicated_server_id": {
+				Description: "The ID of the dedicated server.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"ip": {
+				Description:      "The IP address, without prefix length.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     validateIPAddress,
+				DiffSuppressFunc: suppressEquivalentIPDiff,
+			},
+			"reverse_lookup": {
+				Description: "The reverse lookup associated with the IP.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			"null_routed": {
+				Description: "Whether the IP is null routed or not.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+			"prefix_length": {
+				Description: "The prefix length of the subnet the IP belongs to.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"gateway": {
+				Description: "The gateway of the IP.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"version": {
+				Description: "The version of the IP, either `4` or `6`.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"network_type": {
+				Description: "The type of network the IP belongs to, either `PUBLIC`, `INTERNAL` or `REMOTE_MANAGEMENT`.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"main_ip": {
+				Description: "Whether the IP is the main IP of the dedicated server.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"null_level": {
+				Description: "The null level of the IP.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				parts := strings.SplitN(d.Id(), ":", 2)
+
+				if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+					return nil, fmt.Errorf("Invalid ID format (%s), expected dedicated_server_id:ip", d.Id())
+				}
+
+				ipAddress, err := normalizeIP(parts[1])
+				if err != nil {
+					return nil, err
+				}
+
+				d.Set("dedicated_server_id", parts[0])
+				d.Set("ip", ipAddress)
+				d.SetId(parts[0] + ":" + ipAddress)
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+	}
+}
+
+func resourceDedicatedServerIPCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	serverID := d.Get("dedicated_server_id").(string)
+
+	ipAddress, err := normalizeIP(d.Get("ip").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if strings.Contains(ipAddress, ":") {
+		// individual IPv6 addresses are not listed, they need to be part of an assigned subnet
+		if err := checkIPv6AddressAssigned(ctx, serverID, ipAddress); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	// the IP has to be assigned to the server already, we only take over its settings
+	ip, err := getServerIP(ctx, serverID, ipAddress)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if reverseLookup, ok := d.GetOk("reverse_lookup"); ok && reverseLookup.(string) != ip.ReverseLookup {
+		if err := updateReverseLookup(ctx, serverID, ipAddress, reverseLookup.(string)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if nullRouted := d.GetRawConfig().GetAttr("null_routed"); !nullRouted.IsNull() && nullRouted.True() != ip.NullRouted {
+		if err := setIPNullRouted(ctx, serverID, ipAddress, nullRouted.True()); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId(serverID + ":" + ipAddress)
+
+	return resourceDedicatedServerIPRead(ctx, d, m)
+}
+
+func resourceDedicatedServerIPRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	serverID := d.Get("dedicated_server_id").(string)
+
+	var diags diag.Diagnostics
+
+	ipAddress, err := normalizeIP(d.Get("ip").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ip, err := getServerIP(ctx, serverID, ipAddress)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("ip", ipAddress)
+	d.Set("reverse_lookup", ip.ReverseLookup)
+	d.Set("null_routed", ip.NullRouted)
+	d.Set("prefix_length", ip.GetPrefixLength())
+	d.Set("gateway", ip.GetGateway())
+	d.Set("version", ip.Version)
+	d.Set("network_type", ip.NetworkType)
+	d.Set("main_ip", ip.MainIP)
+	d.Set("null_level", ip.NullLevel)
+
+	return diags
+}
+
+func resourceDedicatedServerIPUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	serverID := d.Get("dedicated_server_id").(string)
+	ipAddress := d.Get("ip").(string)
+
+	if d.HasChange("reverse_lookup") {
+		reverseLookup := d.Get("reverse_lookup").(string)
+		if err := updateReverseLookup(ctx, serverID, ipAddress, reverseLookup); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("null_routed") {
+		if err := setIPNullRouted(ctx, serverID, ipAddress, d.Get("null_routed").(bool)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceDedicatedServerIPRead(ctx, d, m)
+}
+
+func resourceDedicatedServerIPDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// NOTE: IPs are part of the server contract, they cannot be released from here
+	d.SetId("")
+
+	return diags
+}
+
+func setIPNullRouted(ctx context.Context, serverID string, ip string, nullRouted bool) error {
+	if nullRouted {
+		return nullIP(ctx, serverID, ip)
+	}
+	return unnullIP(ctx, serverID, ip)
+}
+
+func checkIPv6AddressAssigned(ctx context.Context, serverID string, ipAddress string) error {
+	ips, err := getAllServerIPs(ctx, serverID, ServerIPFilters{Version: "6"})
+	if err != nil {
+		return err
+	}
+
+	for _, ip := range ips {
+		if ipInPrefix(ipAddress, ip.IP) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("IP %s does not belong to any IPv6 subnet assigned to server %s", ipAddress, serverID)
+}
@@ -0,0 +1,105 @@
+package leaseweb
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceDedicatedServerIPMIReset() *schema.Resource {
+	return &schema.Resource{
+		Description: `
+The ` + "`dedicated_server_ipmi_reset`" + ` resource resets the remote management controller (IPMI) of a dedicated server.
+The reset is launched again every time the resource is replaced, e.g. when ` + "`triggers`" + ` change.
+`,
+		CreateContext: resourceDedicatedServerIPMIResetCreate,
+		ReadContext:   resourceDedicatedServerIPMIResetRead,
+		DeleteContext: resourceDedicatedServerIPMIResetDelete,
+		SchemaVersion: 0,
+		Schema: map[string]*schema.Schema{
+			"dedicated_server_id": {
+				Description: "The ID of the dedicated server.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"power_cycle": {
+				Description: "Whether to power cycle the dedicated server as part of the reset.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     true,
+			},
+			"callback_url": {
+				Description:  "The URL which will receive callbacks when the IPMI reset is finished or failed.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsURLWithScheme([]string{"http", "https"}),
+			},
+			"triggers": {
+				Description: "Arbitrary map of values that, when changed, will reset the IPMI again.",
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"job_uuid": {
+				Description: "The UUID of the IPMI reset job.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+	}
+}
+
+func resourceDedicatedServerIPMIResetCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	serverID := d.Get("dedicated_server_id").(string)
+
+	var payload = Payload{
+		"powerCycle": d.Get("power_cycle").(bool),
+	}
+
+	if d.Get("callback_url") != "" {
+		payload["callbackUrl"] = d.Get("callback_url").(string)
+	}
+
+	ipmiResetJob, err := launchIPMIResetJob(ctx, serverID, &payload)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("job_uuid", ipmiResetJob.UUID)
+	d.SetId(serverID)
+
+	_, err = waitForJob(ctx, serverID, ipmiResetJob.UUID, d.Timeout(schema.TimeoutCreate)-time.Minute, 30*time.Second)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceDedicatedServerIPMIResetRead(ctx, d, m)
+}
+
+func resourceDedicatedServerIPMIResetRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// NOTE: an IPMI reset is a one time action, there is nothing to read back
+
+	return diags
+}
+
+func resourceDedicatedServerIPMIResetDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	d.SetId("")
+
+	return diags
+}
@@ -0,0 +1,236 @@
+package leaseweb
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// notificationSettingUnits lists the units accepted by the API for each notification setting type.
+var notificationSettingUnits = map[string][]string{
+	"bandwidth":   {"Mbps", "Gbps"},
+	"datatraffic": {"GB", "TB"},
+}
+
+// notificationSettingUnitFactors gives the value of each unit in the smallest unit of its notification setting type.
+var notificationSettingUnitFactors = map[string]map[string]float64{
+	"bandwidth": {
+		"Mbps": 1,
+		"Gbps": 1000,
+	},
+	"datatraffic": {
+		"GB": 1,
+		"TB": 1000,
+	},
+}
+
+func resourceDedicatedServerNotificationSettingBandwidth() *schema.Resource {
+	return resourceDedicatedServerNotificationSetting("bandwidth")
+}
+
+func resourceDedicatedServerNotificationSettingDatatraffic() *schema.Resource {
+	return resourceDedicatedServerNotificationSetting("datatraffic")
+}
+
+// resourceDedicatedServerNotificationSetting returns the resource managing the notification settings of a type.
+func resourceDedicatedServerNotificationSetting(notificationType string) *schema.Resource {
+	units := notificationSettingUnits[notificationType]
+
+	return &schema.Resource{
+		Description: `
+The ` + "`dedicated_server_notification_setting_" + notificationType + "`" + ` resource manages a ` + notificationType + `
+notification setting linked to a dedicated server.
+`,
+		CreateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			return resourceDedicatedServerNotificationSettingCreate(ctx, d, notificationType)
+		},
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			return resourceDedicatedServerNotificationSettingRead(ctx, d, notificationType)
+		},
+		UpdateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			return resourceDedicatedServerNotificationSettingUpdate(ctx, d, notificationType)
+		},
+		DeleteContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			return resourceDedicatedServerNotificationSettingDelete(ctx, d, notificationType)
+		},
+		SchemaVersion: 0,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "The ID of the notification setting.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"dedicated_server_id": {
+				Description: "The ID of the dedicated server.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"frequency": {
+				Description: `
+The frequency of the notification.
+Can be either ` + "`DAILY`" + `, ` + "`WEEKLY`" + `, or ` + "`MONTHLY`" + `.
+`,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"DAILY", "WEEKLY", "MONTHLY"}, false),
+			},
+			"threshold": {
+				Description:      "The threshold of the notification.",
+				Type:             schema.TypeFloat,
+				Required:         true,
+				ValidateFunc:     validation.FloatAtLeast(0),
+				DiffSuppressFunc: suppressEquivalentThresholdDiff(notificationType),
+			},
+			"unit": {
+				Description: `
+The unit of the notification.
+Can be either ` + enumerate(units) + `.
+The API may return the threshold in another unit, e.g. ` + "`1 " + units[len(units)-1] + "`" + ` instead of ` + "`1000 " + units[0] + "`" + `,
+equivalent thresholds do not cause a diff.
+`,
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.StringInSlice(units, false),
+				DiffSuppressFunc: suppressEquivalentThresholdDiff(notificationType),
+			},
+			"last_checked_at": {
+				Description: "The date the threshold was last checked, if ever.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"threshold_exceeded_at": {
+				Description: "The date the threshold was last exceeded, if ever.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				parts := strings.SplitN(d.Id(), ":", 2)
+
+				if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+					return nil, fmt.Errorf("Invalid ID format (%s), expected dedicated_server_id:notification_setting_id", d.Id())
+				}
+
+				d.Set("dedicated_server_id", parts[0])
+				d.SetId(parts[1])
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+	}
+}
+
+func resourceDedicatedServerNotificationSettingCreate(ctx context.Context, d *schema.ResourceData, notificationType string) diag.Diagnostics {
+	serverID := d.Get("dedicated_server_id").(string)
+
+	var notificationSetting = NotificationSetting{
+		Frequency: d.Get("frequency").(string),
+		Threshold: d.Get("threshold").(float64),
+		Unit:      d.Get("unit").(string),
+	}
+
+	createdNotificationSetting, err := createDedicatedServerNotificationSetting(ctx, serverID, notificationType, &notificationSetting)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(createdNotificationSetting.ID)
+
+	return resourceDedicatedServerNotificationSettingRead(ctx, d, notificationType)
+}
+
+func resourceDedicatedServerNotificationSettingRead(ctx context.Context, d *schema.ResourceData, notificationType string) diag.Diagnostics {
+	serverID := d.Get("dedicated_server_id").(string)
+	notificationSettingID := d.Get("id").(string)
+
+	var diags diag.Diagnostics
+
+	notificationSetting, err := getDedicatedServerNotificationSetting(ctx, serverID, notificationType, notificationSettingID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("frequency", notificationSetting.Frequency)
+	d.Set("threshold", notificationSetting.Threshold)
+	d.Set("unit", notificationSetting.Unit)
+	d.Set("last_checked_at", notificationSetting.LastCheckedAt)
+	d.Set("threshold_exceeded_at", notificationSetting.ThresholdExceededAt)
+
+	return diags
+}
+
+func resourceDedicatedServerNotificationSettingUpdate(ctx context.Context, d *schema.ResourceData, notificationType string) diag.Diagnostics {
+	serverID := d.Get("dedicated_server_id").(string)
+	notificationSettingID := d.Get("id").(string)
+
+	var notificationSetting = NotificationSetting{
+		Frequency: d.Get("frequency").(string),
+		Threshold: d.Get("threshold").(float64),
+		Unit:      d.Get("unit").(string),
+	}
+
+	if _, err := updateDedicatedServerNotificationSetting(ctx, serverID, notificationType, notificationSettingID, &notificationSetting); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceDedicatedServerNotificationSettingRead(ctx, d, notificationType)
+}
+
+func resourceDedicatedServerNotificationSettingDelete(ctx context.Context, d *schema.ResourceData, notificationType string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	serverID := d.Get("dedicated_server_id").(string)
+	notificationSettingID := d.Get("id").(string)
+
+	if err := deleteDedicatedServerNotificationSetting(ctx, serverID, notificationType, notificationSettingID); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+// suppressEquivalentThresholdDiff suppresses the diff of the threshold and the unit
+// when they represent the same value once converted to the smallest unit of the type.
+func suppressEquivalentThresholdDiff(notificationType string) schema.SchemaDiffSuppressFunc {
+	return func(k, old, new string, d *schema.ResourceData) bool {
+		oldThreshold, newThreshold := d.GetChange("threshold")
+		oldUnit, newUnit := d.GetChange("unit")
+
+		oldValue, ok := canonicalThreshold(notificationType, oldThreshold.(float64), oldUnit.(string))
+		if !ok {
+			return false
+		}
+
+		newValue, ok := canonicalThreshold(notificationType, newThreshold.(float64), newUnit.(string))
+		if !ok {
+			return false
+		}
+
+		// allow for rounding errors of the conversion, e.g. 0.1 TB is not exactly 100 GB
+		return math.Abs(oldValue-newValue) <= 1e-9*math.Max(math.Abs(oldValue), math.Abs(newValue))
+	}
+}
+
+// canonicalThreshold converts a threshold to the smallest unit of its notification setting type.
+func canonicalThreshold(notificationType string, threshold float64, unit string) (float64, bool) {
+	factor, ok := notificationSettingUnitFactors[notificationType][unit]
+	if !ok {
+		return 0, false
+	}
+	return threshold * factor, true
+}
+
+// enumerate returns the values quoted as code and separated by commas, with "or" before the last one.
+func enumerate(values []string) string {
+	quoted := quoteAll(values)
+	if len(quoted) <= 2 {
+		return strings.Join(quoted, " or ")
+	}
+	return strings.Join(quoted[:len(quoted)-1], ", ") + ", or " + quoted[len(quoted)-1]
+}
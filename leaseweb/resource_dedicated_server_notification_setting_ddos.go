@@ -0,0 +1,110 @@
+package leaseweb
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceDedicatedServerNotificationSettingDDoS() *schema.Resource {
+	return &schema.Resource{
+		Description: `
+The ` + "`dedicated_server_notification_setting_ddos`" + ` resource manages the DDoS
+notification settings of a dedicated server.
+The settings are not reset when the resource is destroyed, it is only removed from the state.
+`,
+		CreateContext: resourceDedicatedServerNotificationSettingDDoSCreate,
+		ReadContext:   resourceDedicatedServerNotificationSettingDDoSRead,
+		UpdateContext: resourceDedicatedServerNotificationSettingDDoSUpdate,
+		DeleteContext: resourceDedicatedServerNotificationSettingDDoSDelete,
+		SchemaVersion: 0,
+		Schema: map[string]*schema.Schema{
+			"dedicated_server_id": {
+				Description: "The ID of the dedicated server.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"nulling": {
+				Description: "Whether to be notified when an IP of the dedicated server is null routed because of a DDoS attack.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			"scrubbing": {
+				Description: "Whether to be notified when the traffic of the dedicated server is scrubbed because of a DDoS attack.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("dedicated_server_id", d.Id())
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+	}
+}
+
+func resourceDedicatedServerNotificationSettingDDoSCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	serverID := d.Get("dedicated_server_id").(string)
+
+	if err := updateDedicatedServerDDoSNotificationSetting(ctx, serverID, expandDDoSNotificationSetting(d)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(serverID)
+
+	return resourceDedicatedServerNotificationSettingDDoSRead(ctx, d, m)
+}
+
+func resourceDedicatedServerNotificationSettingDDoSRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	serverID := d.Get("dedicated_server_id").(string)
+
+	var diags diag.Diagnostics
+
+	notificationSetting, err := getDedicatedServerDDoSNotificationSetting(ctx, serverID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("nulling", notificationSetting.Nulling == "ENABLED")
+	d.Set("scrubbing", notificationSetting.Scrubbing == "ENABLED")
+
+	return diags
+}
+
+func resourceDedicatedServerNotificationSettingDDoSUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	serverID := d.Get("dedicated_server_id").(string)
+
+	if err := updateDedicatedServerDDoSNotificationSetting(ctx, serverID, expandDDoSNotificationSetting(d)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceDedicatedServerNotificationSettingDDoSRead(ctx, d, m)
+}
+
+func resourceDedicatedServerNotificationSettingDDoSDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// NOTE: the DDoS notification settings always exist, they cannot be removed
+	d.SetId("")
+
+	return diags
+}
+
+func expandDDoSNotificationSetting(d *schema.ResourceData) *DDoSNotificationSetting {
+	return &DDoSNotificationSetting{
+		Nulling:   notificationStatus(d.Get("nulling").(bool)),
+		Scrubbing: notificationStatus(d.Get("scrubbing").(bool)),
+	}
+}
+
+func notificationStatus(enabled bool) string {
+	if enabled {
+		return "ENABLED"
+	}
+	return "DISABLED"
+}
@@ -0,0 +1,83 @@
+package leaseweb
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceDedicatedServerPowerCycle() *schema.Resource {
+	return &schema.Resource{
+		Description: `
+The ` + "`dedicated_server_power_cycle`" + ` resource power cycles a dedicated server.
+The server is power cycled again every time the resource is replaced, e.g. when ` + "`triggers`" + ` change.
+`,
+		CreateContext: resourceDedicatedServerPowerCycleCreate,
+		ReadContext:   resourceDedicatedServerPowerCycleRead,
+		DeleteContext: resourceDedicatedServerPowerCycleDelete,
+		SchemaVersion: 0,
+		Schema: map[string]*schema.Schema{
+			"dedicated_server_id": {
+				Description: "The ID of the dedicated server.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"triggers": {
+				Description: "Arbitrary map of values that, when changed, will power cycle the dedicated server again.",
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"wait_for_power_on": {
+				Description: "Whether to wait for the dedicated server to be powered on again.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     true,
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+	}
+}
+
+func resourceDedicatedServerPowerCycleCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	serverID := d.Get("dedicated_server_id").(string)
+
+	if err := powerCycleServer(ctx, serverID); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(serverID)
+
+	if d.Get("wait_for_power_on").(bool) {
+		if err := waitForPowerCycle(ctx, serverID, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceDedicatedServerPowerCycleRead(ctx, d, m)
+}
+
+func resourceDedicatedServerPowerCycleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// NOTE: a power cycle is a one time action, there is nothing to read back
+
+	return diags
+}
+
+func resourceDedicatedServerPowerCycleDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	d.SetId("")
+
+	return diags
+}
@@ -0,0 +1,187 @@
+package leaseweb
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceDedicatedServerPrivateNetwork() *schema.Resource {
+	return &schema.Resource{
+		Description: `
+The ` + "`dedicated_server_private_network`" + ` resource manages the membership of a dedicated server
+to a private network.
+`,
+		CreateContext: resourceDedicatedServerPrivateNetworkCreate,
+		ReadContext:   resourceDedicatedServerPrivateNetworkRead,
+		DeleteContext: resourceDedicatedServerPrivateNetworkDelete,
+		SchemaVersion: 0,
+		Schema: map[string]*schema.Schema{
+			"dedicated_server_id": {
+				Description: "The ID of the dedicated server.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"private_network_id": {
+				Description: "The ID of the private network.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"link_speed": {
+				Description: `
+The speed of the link to the private network in Mbps.
+Can be either ` + "`100`" + `, ` + "`1000`" + ` or ` + "`10000`" + `.
+`,
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntInSlice([]int{100, 1000, 10000}),
+			},
+			"status": {
+				Description: "The status of the dedicated server in the private network.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"subnet": {
+				Description: "The subnet of the private network.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"vlan_id": {
+				Description: "The VLAN ID of the private network.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"private_ip": {
+				Description: "The IP of the internal network interface of the dedicated server, without prefix length.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				parts := strings.SplitN(d.Id(), ":", 2)
+
+				if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+					return nil, fmt.Errorf("Invalid ID format (%s), expected dedicated_server_id:private_network_id", d.Id())
+				}
+
+				d.Set("dedicated_server_id", parts[0])
+				d.Set("private_network_id", parts[1])
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+	}
+}
+
+func resourceDedicatedServerPrivateNetworkCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	serverID := d.Get("dedicated_server_id").(string)
+	privateNetworkID := d.Get("private_network_id").(string)
+
+	if err := addServerToPrivateNetwork(ctx, serverID, privateNetworkID, d.Get("link_speed").(int)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(serverID + ":" + privateNetworkID)
+
+	// the private network is configured asynchronously, wait for it to be done before reading the resource
+	if err := waitForPrivateNetworkMembership(ctx, serverID, privateNetworkID, true, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceDedicatedServerPrivateNetworkRead(ctx, d, m)
+}
+
+func resourceDedicatedServerPrivateNetworkRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	serverID := d.Get("dedicated_server_id").(string)
+	privateNetworkID := d.Get("private_network_id").(string)
+
+	var diags diag.Diagnostics
+
+	server, err := getServer(ctx, serverID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	privateNetwork := server.GetPrivateNetwork(privateNetworkID)
+	if privateNetwork == nil {
+		// the dedicated server has been removed from the private network outside of terraform
+		d.SetId("")
+		return diags
+	}
+
+	d.Set("link_speed", privateNetwork.LinkSpeed)
+	d.Set("status", privateNetwork.Status)
+	d.Set("subnet", privateNetwork.Subnet)
+	d.Set("vlan_id", privateNetwork.VLANID)
+	d.Set("private_ip", server.NetworkInterfaces.Internal.IP)
+
+	return diags
+}
+
+func resourceDedicatedServerPrivateNetworkDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	serverID := d.Get("dedicated_server_id").(string)
+	privateNetworkID := d.Get("private_network_id").(string)
+
+	if err := removeServerFromPrivateNetwork(ctx, serverID, privateNetworkID); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := waitForPrivateNetworkMembership(ctx, serverID, privateNetworkID, false, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func waitForPrivateNetworkMembership(ctx context.Context, serverID string, privateNetworkID string, member bool, timeout time.Duration) error {
+	target := "removed"
+	if member {
+		target = "CONFIGURED"
+	}
+
+	membershipStateConf := &resource.StateChangeConf{
+		Pending: []string{"pending"},
+		Target:  []string{target},
+		Refresh: func() (interface{}, string, error) {
+			server, err := getServer(ctx, serverID)
+			if err != nil {
+				return nil, "error", err
+			}
+
+			privateNetwork := server.GetPrivateNetwork(privateNetworkID)
+			if privateNetwork == nil {
+				if member {
+					return server, "pending", nil
+				}
+				return server, target, nil
+			}
+
+			if member && privateNetwork.Status == target {
+				return server, target, nil
+			}
+			return server, "pending", nil
+		},
+		Timeout:      timeout,
+		Delay:        10 * time.Second,
+		PollInterval: 30 * time.Second,
+	}
+	_, err := membershipStateConf.WaitForStateContext(ctx)
+
+	return err
+}
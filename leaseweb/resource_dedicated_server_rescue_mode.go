@@ -0,0 +1,207 @@
+package leaseweb
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceDedicatedServerRescueMode() *schema.Resource {
+	return &schema.Resource{
+		Description: `
+The ` + "`dedicated_server_rescue_mode`" + ` resource boots a dedicated server in rescue mode.
+Destroying the resource power cycles the dedicated server to boot it out of rescue mode
+and waits for it to be powered on again.
+`,
+		CreateContext: resourceDedicatedServerRescueModeCreate,
+		ReadContext:   resourceDedicatedServerRescueModeRead,
+		DeleteContext: resourceDedicatedServerRescueModeDelete,
+		SchemaVersion: 0,
+		Schema: map[string]*schema.Schema{
+			"dedicated_server_id": {
+				Description: "The ID of the dedicated server.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"rescue_image_id": {
+				Description: "The ID of the rescue image to boot.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"callback_url": {
+				Description:  "The URL which will receive callbacks when the rescue mode is ready or failed.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsURLWithScheme([]string{"http", "https"}),
+			},
+			"ssh_keys": {
+				Description: "List of public SSH keys to authorize in the rescue environment.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				ForceNew:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"post_install_script": {
+				Description: "Script to run right after the rescue environment is booted.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"password": {
+				Description: "The root password to configure in the rescue environment. A password is generated when it is not set.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Sensitive:   true,
+			},
+			"job_uuid": {
+				Description: "The UUID of the rescue mode job.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"credential_username": {
+				Description: "The username to log in the rescue environment.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"credential_password": {
+				Description: "The password to log in the rescue environment.",
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+	}
+}
+
+func resourceDedicatedServerRescueModeCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	serverID := d.Get("dedicated_server_id").(string)
+
+	var payload = Payload{
+		"rescueImageId": d.Get("rescue_image_id").(string),
+		"powerCycle":    true,
+	}
+
+	if d.Get("callback_url") != "" {
+		payload["callbackUrl"] = d.Get("callback_url").(string)
+	}
+
+	sshKeysSet := d.Get("ssh_keys").(*schema.Set)
+	if sshKeysSet.Len() != 0 {
+		sshKeys := make([]string, sshKeysSet.Len())
+		for i, sshKey := range sshKeysSet.List() {
+			sshKeys[i] = sshKey.(string)
+		}
+		payload["sshKeys"] = strings.Join(sshKeys, "\n")
+	}
+
+	if d.Get("post_install_script") != "" {
+		payload["postInstallScript"] = base64.StdEncoding.EncodeToString([]byte(d.Get("post_install_script").(string)))
+	}
+
+	if d.Get("password") != "" {
+		payload["password"] = d.Get("password").(string)
+	}
+
+	rescueModeJob, err := launchRescueModeJob(ctx, serverID, &payload)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("job_uuid", rescueModeJob.UUID)
+	d.SetId(serverID)
+
+	rescueModeJob, err = waitForJob(ctx, serverID, rescueModeJob.UUID, d.Timeout(schema.TimeoutCreate)-time.Minute, 30*time.Second)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	credential, err := getRescueModeCredential(ctx, serverID, rescueModeJob)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("credential_username", credential.Username)
+	d.Set("credential_password", credential.Password)
+
+	return resourceDedicatedServerRescueModeRead(ctx, d, m)
+}
+
+func resourceDedicatedServerRescueModeRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	serverID := d.Get("dedicated_server_id").(string)
+
+	var diags diag.Diagnostics
+
+	rescueModeJob, err := getJob(ctx, serverID, d.Get("job_uuid").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("job_uuid", rescueModeJob.UUID)
+
+	if rescueImageID, ok := rescueModeJob.Payload["rescueImageId"]; ok {
+		d.Set("rescue_image_id", rescueImageID)
+	}
+
+	if callbackURL, ok := rescueModeJob.Payload["callbackUrl"]; ok {
+		d.Set("callback_url", callbackURL)
+	}
+
+	return diags
+}
+
+func resourceDedicatedServerRescueModeDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	serverID := d.Get("dedicated_server_id").(string)
+
+	// power cycling the server boots it from its disks again, the off state of the cycle is too short to be waited for
+	if err := powerCycleServer(ctx, serverID); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := waitForPowerCycle(ctx, serverID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+// getRescueModeCredential returns the rescue mode credential created by the given job. The credentials of
+// previous rescue sessions can still be stored with the server, so the password of the job has to match.
+func getRescueModeCredential(ctx context.Context, serverID string, rescueModeJob *Job) (*Credential, error) {
+	password, _ := rescueModeJob.Payload["password"].(string)
+	if password == "" {
+		return nil, fmt.Errorf("rescue mode job %s of server %s does not contain a password", rescueModeJob.UUID, serverID)
+	}
+
+	credentials, err := getAllDedicatedServerCredentials(ctx, serverID, "RESCUE_MODE")
+	if err != nil {
+		return nil, err
+	}
+
+	for _, c := range credentials {
+		credential, err := getDedicatedServerCredential(ctx, serverID, c.Type, c.Username)
+		if err != nil {
+			return nil, err
+		}
+		if credential.Password == password {
+			return credential, nil
+		}
+	}
+
+	return nil, fmt.Errorf("no rescue mode credential of job %s found for server %s", rescueModeJob.UUID, serverID)
+}
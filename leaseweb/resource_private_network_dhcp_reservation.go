@@ -0,0 +1,171 @@
+package leaseweb
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourcePrivateNetworkDHCPReservation() *schema.Resource {
+	return &schema.Resource{
+		Description: `
+The ` + "`private_network_dhcp_reservation`" + ` resource manages a DHCP reservation of a private network,
+binding an IP of the private network to a MAC address.
+`,
+		CreateContext: resourcePrivateNetworkDHCPReservationCreate,
+		ReadContext:   resourcePrivateNetworkDHCPReservationRead,
+		DeleteContext: resourcePrivateNetworkDHCPReservationDelete,
+		SchemaVersion: 0,
+		Schema: map[string]*schema.Schema{
+			"private_network_id": {
+				Description: "The ID of the private network.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"ip": {
+				Description:      "The reserved IP, a private IPv4 address of the subnet of the private network without prefix length.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     validatePrivateIPAddress,
+				DiffSuppressFunc: suppressEquivalentIPDiff,
+			},
+			"mac_address": {
+				Description:  "The MAC address the IP is reserved for.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsMACAddress,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return strings.EqualFold(old, new)
+				},
+			},
+			"sticky": {
+				Description: "Whether the IP stays reserved for the MAC address when it is not in use or not.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				parts := strings.SplitN(d.Id(), ":", 2)
+
+				if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+					return nil, fmt.Errorf("Invalid ID format (%s), expected private_network_id:ip", d.Id())
+				}
+
+				ipAddress, err := normalizeIP(parts[1])
+				if err != nil {
+					return nil, err
+				}
+
+				d.Set("private_network_id", parts[0])
+				d.Set("ip", ipAddress)
+				d.SetId(parts[0] + ":" + ipAddress)
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+	}
+}
+
+func resourcePrivateNetworkDHCPReservationCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	privateNetworkID := d.Get("private_network_id").(string)
+
+	ipAddress, err := normalizeIP(d.Get("ip").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := checkPrivateNetworkIP(ctx, privateNetworkID, ipAddress); err != nil {
+		return diag.FromErr(err)
+	}
+
+	var reservation = DHCPReservation{
+		IP:     ipAddress,
+		MAC:    d.Get("mac_address").(string),
+		Sticky: d.Get("sticky").(bool),
+	}
+
+	if _, err := createDHCPReservation(ctx, privateNetworkID, &reservation); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(privateNetworkID + ":" + ipAddress)
+
+	return resourcePrivateNetworkDHCPReservationRead(ctx, d, m)
+}
+
+func resourcePrivateNetworkDHCPReservationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	privateNetworkID := d.Get("private_network_id").(string)
+
+	var diags diag.Diagnostics
+
+	ipAddress, err := normalizeIP(d.Get("ip").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	reservations, err := getAllDHCPReservations(ctx, privateNetworkID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	for _, reservation := range reservations {
+		if ip, _ := splitIPPrefix(reservation.IP); ip == ipAddress {
+			d.Set("ip", ipAddress)
+			d.Set("mac_address", reservation.MAC)
+			d.Set("sticky", reservation.Sticky)
+			return diags
+		}
+	}
+
+	// the reservation has been removed outside of terraform
+	d.SetId("")
+
+	return diags
+}
+
+func resourcePrivateNetworkDHCPReservationDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	privateNetworkID := d.Get("private_network_id").(string)
+
+	ipAddress, err := normalizeIP(d.Get("ip").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := deleteDHCPReservation(ctx, privateNetworkID, ipAddress); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func checkPrivateNetworkIP(ctx context.Context, privateNetworkID string, ipAddress string) error {
+	privateNetwork, err := getPrivateNetwork(ctx, privateNetworkID)
+	if err != nil {
+		return err
+	}
+
+	subnets := privateNetwork.GetSubnets()
+	if len(subnets) == 0 {
+		return fmt.Errorf("private network %s has no subnet yet, a server has to be added to it first", privateNetworkID)
+	}
+
+	for _, subnet := range subnets {
+		if ipInPrefix(ipAddress, subnet) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("IP %s is not part of the subnet of private network %s (%s)", ipAddress, privateNetworkID, strings.Join(subnets, ", "))
+}
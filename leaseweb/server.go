@@ -0,0 +1,411 @@
+package leaseweb
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dedicatedServerSchema returns the computed attributes describing a dedicated server.
+func dedicatedServerSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Description: "The ID of the dedicated server.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"reference": {
+			Description: "The reference of the dedicated server.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"asset_id": {
+			Description: "The asset ID of the dedicated server.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"serial_number": {
+			Description: "The serial number of the dedicated server.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"public_ip": {
+			Description: "The public IP of the dedicated server.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"remote_management_ip": {
+			Description: "The remote management IP of the dedicated server.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"location": {
+			Description: `
+The location of the server.
+Available fields are ` + "`rack`" + `, ` + "`site`" + `, ` + "`suite`" + ` and ` + "`unit`" + `.
+`,
+			Type:     schema.TypeMap,
+			Computed: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"rack_type": {
+			Description: "The type of rack the dedicated server is in, e.g. `SHARED` or `PRIVATE`.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"contract": dedicatedServerContractSchema(),
+		"specs":    dedicatedServerSpecsSchema(),
+		"network_interfaces": {
+			Description: "The network interfaces of the dedicated server.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"public":            serverNetworkInterfaceSchema("public"),
+					"internal":          serverNetworkInterfaceSchema("internal"),
+					"remote_management": serverNetworkInterfaceSchema("remote management"),
+				},
+			},
+		},
+	}
+}
+
+// dedicatedServerContractSchema returns the computed attribute describing the contract of a dedicated server.
+func dedicatedServerContractSchema() *schema.Schema {
+	return &schema.Schema{
+		Description: "The contract of the dedicated server.",
+		Type:        schema.TypeList,
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": {
+					Description: "The ID of the contract.",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+				"customer_id": {
+					Description: "The ID of the customer owning the contract.",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+				"sales_org_id": {
+					Description: "The ID of the sales organization of the contract.",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+				"delivery_status": {
+					Description: "The delivery status of the contract.",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+				"status": {
+					Description: "The status of the contract.",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+				"contract_type": {
+					Description: "The type of the contract, e.g. `NORMAL`.",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+				"contract_term": {
+					Description: "The term of the contract, in months.",
+					Type:        schema.TypeInt,
+					Computed:    true,
+				},
+				"billing_cycle": {
+					Description: "The billing cycle of the contract, in `billing_frequency`.",
+					Type:        schema.TypeInt,
+					Computed:    true,
+				},
+				"billing_frequency": {
+					Description: "The billing frequency of the contract, e.g. `MONTH`.",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+				"price_per_frequency": {
+					Description: "The price of the contract per `billing_frequency`, in `currency`.",
+					Type:        schema.TypeFloat,
+					Computed:    true,
+				},
+				"currency": {
+					Description: "The currency of `price_per_frequency`.",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+				"sla": {
+					Description: "The service level agreement of the contract.",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+				"starts_at": {
+					Description: "The date the contract starts.",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+				"ends_at": {
+					Description: "The date the contract ends, if any.",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+				"private_network_port_speed": {
+					Description: "The speed of the private network port in Mbps, if any.",
+					Type:        schema.TypeInt,
+					Computed:    true,
+				},
+				"network_traffic_type": {
+					Description: "The type of the network traffic of the contract, e.g. `FLATFEE` or `DATATRAFFIC`.",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+				"connectivity_type": {
+					Description: "The connectivity type of the network traffic of the contract.",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+				"traffic_type": {
+					Description: "The traffic type of the network traffic of the contract, e.g. `PREMIUM`.",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+				"datatraffic_limit": {
+					Description: "The data pack included in the contract, in `datatraffic_unit`.",
+					Type:        schema.TypeInt,
+					Computed:    true,
+				},
+				"datatraffic_unit": {
+					Description: "The unit of `datatraffic_limit`.",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+			},
+		},
+	}
+}
+
+// dedicatedServerSpecsSchema returns the computed attribute describing the hardware specifications of a dedicated server.
+func dedicatedServerSpecsSchema() *schema.Schema {
+	return &schema.Schema{
+		Description: "The hardware specifications of the dedicated server, as sold.",
+		Type:        schema.TypeList,
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"chassis": {
+					Description: "The chassis of the dedicated server.",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+				"hardware_raid_capable": {
+					Description: "Whether the dedicated server supports hardware RAID or not.",
+					Type:        schema.TypeBool,
+					Computed:    true,
+				},
+				"cpu_quantity": {
+					Description: "The number of CPUs.",
+					Type:        schema.TypeInt,
+					Computed:    true,
+				},
+				"cpu_type": {
+					Description: "The type of the CPUs.",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+				"ram_size": {
+					Description: "The size of the memory, in `ram_unit`.",
+					Type:        schema.TypeInt,
+					Computed:    true,
+				},
+				"ram_unit": {
+					Description: "The unit of `ram_size`.",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+				"hdds": {
+					Description: "List of the disk groups.",
+					Type:        schema.TypeList,
+					Computed:    true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"id": {
+								Description: "The ID of the disk type.",
+								Type:        schema.TypeString,
+								Computed:    true,
+							},
+							"amount": {
+								Description: "The number of disks.",
+								Type:        schema.TypeInt,
+								Computed:    true,
+							},
+							"size": {
+								Description: "The size of each disk, in `unit`.",
+								Type:        schema.TypeFloat,
+								Computed:    true,
+							},
+							"unit": {
+								Description: "The unit of `size`.",
+								Type:        schema.TypeString,
+								Computed:    true,
+							},
+							"type": {
+								Description: "The type of the disks, e.g. `SATA` or `SSD`.",
+								Type:        schema.TypeString,
+								Computed:    true,
+							},
+							"performance_type": {
+								Description: "The performance type of the disks, if any.",
+								Type:        schema.TypeString,
+								Computed:    true,
+							},
+						},
+					},
+				},
+				"pci_cards": {
+					Description: "List of the descriptions of the PCI cards.",
+					Type:        schema.TypeList,
+					Computed:    true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+			},
+		},
+	}
+}
+
+func serverNetworkInterfaceSchema(name string) *schema.Schema {
+	return &schema.Schema{
+		Description: "The " + name + " network interface, if any.",
+		Type:        schema.TypeList,
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"mac_address": {
+					Description: "The MAC address of the network interface.",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+				"ip": {
+					Description: "The IP of the network interface, without prefix length.",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+				"prefix_length": {
+					Description: "The prefix length of the IP of the network interface.",
+					Type:        schema.TypeInt,
+					Computed:    true,
+				},
+				"gateway": {
+					Description: "The gateway of the network interface.",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+			},
+		},
+	}
+}
+
+// flattenDedicatedServer returns the values of the attributes of dedicatedServerSchema for a server.
+func flattenDedicatedServer(server *Server) map[string]interface{} {
+	return map[string]interface{}{
+		"id":                   server.ID,
+		"reference":            server.Contract.Reference,
+		"asset_id":             server.AssetID,
+		"serial_number":        server.SerialNumber,
+		"public_ip":            server.NetworkInterfaces.Public.IP,
+		"remote_management_ip": server.NetworkInterfaces.RemoteManagement.IP,
+		"location": map[string]string{
+			"rack":  server.Location.Rack,
+			"site":  server.Location.Site,
+			"suite": server.Location.Suite,
+			"unit":  server.Location.Unit,
+		},
+		"rack_type": server.Rack.Type,
+		"contract":  flattenServerContract(server),
+		"specs":     flattenServerSpecs(server),
+		"network_interfaces": []map[string]interface{}{{
+			"public":            flattenServerNetworkInterface(server.NetworkInterfaces.Public),
+			"internal":          flattenServerNetworkInterface(server.NetworkInterfaces.Internal),
+			"remote_management": flattenServerNetworkInterface(server.NetworkInterfaces.RemoteManagement),
+		}},
+	}
+}
+
+func flattenServerContract(server *Server) []map[string]interface{} {
+	return []map[string]interface{}{{
+		"id":                         server.Contract.ID,
+		"customer_id":                server.Contract.CustomerID,
+		"sales_org_id":               server.Contract.SalesOrgID,
+		"delivery_status":            server.Contract.DeliveryStatus,
+		"status":                     server.Contract.Status,
+		"contract_type":              server.Contract.ContractType,
+		"contract_term":              int(server.Contract.ContractTerm),
+		"billing_cycle":              int(server.Contract.BillingCycle),
+		"billing_frequency":          server.Contract.BillingFrequency,
+		"price_per_frequency":        server.Contract.PricePerFrequency,
+		"currency":                   server.Contract.Currency,
+		"sla":                        server.Contract.SLA,
+		"starts_at":                  server.Contract.StartsAt,
+		"ends_at":                    server.Contract.EndsAt,
+		"private_network_port_speed": int(server.Contract.PrivateNetworkPortSpeed),
+		"network_traffic_type":       server.Contract.NetworkTraffic.Type,
+		"connectivity_type":          server.Contract.NetworkTraffic.ConnectivityType,
+		"traffic_type":               server.Contract.NetworkTraffic.TrafficType,
+		"datatraffic_limit":          int(server.Contract.NetworkTraffic.DatatrafficLimit),
+		"datatraffic_unit":           server.Contract.NetworkTraffic.DatatrafficUnit,
+	}}
+}
+
+func flattenServerSpecs(server *Server) []map[string]interface{} {
+	hdds := make([]map[string]interface{}, len(server.Specs.HDD))
+	for i, hdd := range server.Specs.HDD {
+		hdds[i] = map[string]interface{}{
+			"id":               hdd.ID,
+			"amount":           hdd.Amount,
+			"size":             hdd.Size,
+			"unit":             hdd.Unit,
+			"type":             hdd.Type,
+			"performance_type": hdd.PerformanceType,
+		}
+	}
+
+	pciCards := make([]string, len(server.Specs.PCICards))
+	for i, pciCard := range server.Specs.PCICards {
+		pciCards[i] = pciCard.Description
+	}
+
+	return []map[string]interface{}{{
+		"chassis":               server.Specs.Chassis,
+		"hardware_raid_capable": server.Specs.HardwareRaidCapable,
+		"cpu_quantity":          server.Specs.CPU.Quantity,
+		"cpu_type":              server.Specs.CPU.Type,
+		"ram_size":              server.Specs.RAMSize,
+		"ram_unit":              server.Specs.RAMUnit,
+		"hdds":                  hdds,
+		"pci_cards":             pciCards,
+	}}
+}
+
+func flattenServerNetworkInterface(networkInterface ServerNetworkInterface) []map[string]interface{} {
+	if networkInterface.MAC == "" && networkInterface.IP == "" {
+		return []map[string]interface{}{}
+	}
+
+	return []map[string]interface{}{{
+		"mac_address":   networkInterface.MAC,
+		"ip":            networkInterface.IP,
+		"prefix_length": networkInterface.PrefixLength,
+		"gateway":       networkInterface.Gateway,
+	}}
+}
+
+// setDedicatedServer sets the attributes of dedicatedServerSchema from a server.
+func setDedicatedServer(d *schema.ResourceData, server *Server) error {
+	for k, v := range flattenDedicatedServer(server) {
+		if err := d.Set(k, v); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -1,6 +1,8 @@
 package main
 
 import (
+	"os"
+
 	"github.com/leaseweb/terraform-provider-leaseweb/leaseweb"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -11,6 +13,10 @@ import (
 //go:generate go run github.com/hashicorp/terraform-plugin-docs/cmd/tfplugindocs generate --rendered-provider-name Leaseweb
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "generate-imports" {
+		os.Exit(leaseweb.RunImportGenerator(os.Args[2:]))
+	}
+
 	plugin.Serve(&plugin.ServeOpts{
 		ProviderFunc: func() *schema.Provider {
 			return leaseweb.Provider()